@@ -0,0 +1,17 @@
+// Package backoff computes delays between retries of failed attempts
+package backoff
+
+import "time"
+
+// Exponential returns the delay before the next attempt after n failed
+// attempts. The delay starts at base and doubles on each failure up to max.
+func Exponential(base, max time.Duration, n int) time.Duration {
+	d := base
+	for i := 1; i < n && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
@@ -0,0 +1,27 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponential(t *testing.T) {
+	for _, tt := range []struct {
+		base time.Duration
+		max  time.Duration
+		n    int
+		want time.Duration
+	}{
+		{time.Second, time.Minute, 0, time.Second},
+		{time.Second, time.Minute, 1, time.Second},
+		{time.Second, time.Minute, 2, 2 * time.Second},
+		{time.Second, time.Minute, 5, 16 * time.Second},
+		{time.Second, time.Minute, 7, time.Minute},
+		{time.Second, time.Minute, 1000, time.Minute},
+		{time.Minute, time.Second, 1, time.Second},
+	} {
+		assert.Equal(t, tt.want, Exponential(tt.base, tt.max, tt.n), "%s %s %d", tt.base, tt.max, tt.n)
+	}
+}
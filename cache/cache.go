@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Backend stores encoded values with expiration
+type Backend interface {
+	// Get returns the value of the key, and false if the key is missing or expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value of the key for ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the keys. Missing keys are ignored.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Stats is the number of cache lookups by result
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	// Errors is the number of backend failures, which are served from the loader instead
+	Errors uint64
+}
+
+// Cache is a read-through cache of JSON encoded values.
+// Concurrent misses of the same key are collapsed into a single load.
+type Cache struct {
+	backend Backend
+	ttl     time.Duration
+	group   singleflight.Group
+
+	hits   uint64
+	misses uint64
+	errors uint64
+}
+
+// New returns a new Cache which keeps values in the backend for ttl
+func New(backend Backend, ttl time.Duration) *Cache {
+	return &Cache{
+		backend: backend,
+		ttl:     ttl,
+	}
+}
+
+// Fetch decodes the value of the key into v. On a miss the value is loaded
+// with load and stored for later lookups. Errors of load are returned as is
+// and nothing is stored.
+func (c *Cache) Fetch(ctx context.Context, key string, v interface{}, load func() (interface{}, error)) error {
+	b, ok, err := c.backend.Get(ctx, key)
+	if err != nil {
+		atomic.AddUint64(&c.errors, 1)
+	}
+	if ok {
+		if err := json.Unmarshal(b, v); err == nil {
+			atomic.AddUint64(&c.hits, 1)
+			return nil
+		}
+		// a value encoded by an older version, overwrite it
+		atomic.AddUint64(&c.errors, 1)
+	}
+	atomic.AddUint64(&c.misses, 1)
+
+	r, err, _ := c.group.Do(key, func() (interface{}, error) {
+		loaded, err := load()
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(loaded)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.backend.Set(ctx, key, b, c.ttl); err != nil {
+			atomic.AddUint64(&c.errors, 1)
+		}
+		return b, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(r.([]byte), v)
+}
+
+// Delete invalidates the keys. Failures are counted as errors, and the
+// entries are left until they expire.
+func (c *Cache) Delete(ctx context.Context, keys ...string) {
+	for _, k := range keys {
+		c.group.Forget(k)
+	}
+
+	if err := c.backend.Delete(ctx, keys...); err != nil {
+		atomic.AddUint64(&c.errors, 1)
+	}
+}
+
+// Stats returns the number of lookups so far
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+		Errors: atomic.LoadUint64(&c.errors),
+	}
+}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// failingBackend fails every operation
+type failingBackend struct{}
+
+func (failingBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return nil, false, errors.New("unavailable")
+}
+
+func (failingBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return errors.New("unavailable")
+}
+
+func (failingBackend) Delete(ctx context.Context, keys ...string) error {
+	return errors.New("unavailable")
+}
+
+type value struct {
+	Name string `json:"name"`
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+	c := New(NewMemory(10), time.Minute)
+
+	loads := 0
+	fetch := func(key, name string) (value, error) {
+		var v value
+		err := c.Fetch(ctx, key, &v, func() (interface{}, error) {
+			loads++
+			return value{Name: name}, nil
+		})
+		return v, err
+	}
+
+	v, err := fetch("a", "foo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "foo", v.Name)
+	}
+	assert.Equal(t, Stats{Misses: 1}, c.Stats())
+
+	// hits are served without loading
+	v, err = fetch("a", "bar")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "foo", v.Name)
+	}
+	assert.Equal(t, 1, loads)
+	assert.Equal(t, Stats{Hits: 1, Misses: 1}, c.Stats())
+
+	// invalidated keys are loaded again
+	c.Delete(ctx, "a")
+	v, err = fetch("a", "bar")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "bar", v.Name)
+	}
+	assert.Equal(t, 2, loads)
+	assert.Equal(t, Stats{Hits: 1, Misses: 2}, c.Stats())
+
+	// errors of load are returned and nothing is stored
+	var got value
+	err = c.Fetch(ctx, "b", &got, func() (interface{}, error) {
+		return nil, errors.New("not found")
+	})
+	assert.EqualError(t, err, "not found")
+	v, err = fetch("b", "baz")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "baz", v.Name)
+	}
+
+	// values which cannot be decoded are overwritten
+	c.backend.Set(ctx, "c", []byte("not json"), time.Minute)
+	before := c.Stats()
+	v, err = fetch("c", "qux")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "qux", v.Name)
+	}
+	assert.Equal(t, before.Errors+1, c.Stats().Errors)
+	v, err = fetch("c", "quux")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "qux", v.Name)
+	}
+}
+
+func TestFetchFailingBackend(t *testing.T) {
+	ctx := context.Background()
+	c := New(failingBackend{}, time.Minute)
+
+	// values are served from the loader
+	var v value
+	err := c.Fetch(ctx, "a", &v, func() (interface{}, error) {
+		return value{Name: "foo"}, nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "foo", v.Name)
+	}
+	assert.Equal(t, Stats{Misses: 1, Errors: 2}, c.Stats(), "failed get and set")
+
+	c.Delete(ctx, "a")
+	assert.Equal(t, uint64(3), c.Stats().Errors)
+}
+
+func TestFetchCollapsesConcurrentMisses(t *testing.T) {
+	ctx := context.Background()
+	c := New(NewMemory(10), time.Minute)
+
+	const n = 10
+	var loads int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	values := make([]value, n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = c.Fetch(ctx, "a", &values[i], func() (interface{}, error) {
+				atomic.AddInt32(&loads, 1)
+				<-release
+				return value{Name: "foo"}, nil
+			})
+		}(i)
+	}
+
+	// every lookup misses before the first load completes
+	assert.Eventually(t, func() bool { return c.Stats().Misses == n }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	for i := 0; i < n; i++ {
+		if assert.NoError(t, errs[i]) {
+			assert.Equal(t, "foo", values[i].Name)
+		}
+	}
+}
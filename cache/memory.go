@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultMemorySize is the default number of entries kept in memory
+const DefaultMemorySize = 10000
+
+// Memory is an in-process Backend which evicts the least recently used entry
+// when it is full, and drops expired entries when they are looked up
+type Memory struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	lru     *list.List // front is the most recently used
+	now     func() time.Time
+}
+
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemory returns a new Memory which keeps up to size entries
+func NewMemory(size int) *Memory {
+	if size <= 0 {
+		size = DefaultMemorySize
+	}
+	return &Memory{
+		size:    size,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value of the key unless it is expired
+func (m *Memory) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	e := el.Value.(*memoryEntry)
+	if !m.now().Before(e.expiresAt) {
+		m.remove(el)
+		return nil, false, nil
+	}
+
+	m.lru.MoveToFront(el)
+	return e.value, true, nil
+}
+
+// Set stores the value of the key, evicting the least recently used entry if full
+func (m *Memory) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expiresAt := m.now().Add(ttl)
+	if el, ok := m.entries[key]; ok {
+		e := el.Value.(*memoryEntry)
+		e.value, e.expiresAt = value, expiresAt
+		m.lru.MoveToFront(el)
+		return nil
+	}
+
+	m.entries[key] = m.lru.PushFront(&memoryEntry{key: key, value: value, expiresAt: expiresAt})
+	for m.lru.Len() > m.size {
+		m.remove(m.lru.Back())
+	}
+
+	return nil
+}
+
+// Delete removes the keys
+func (m *Memory) Delete(ctx context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, k := range keys {
+		if el, ok := m.entries[k]; ok {
+			m.remove(el)
+		}
+	}
+
+	return nil
+}
+
+// Len returns the number of entries including expired ones not looked up yet
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.lru.Len()
+}
+
+func (m *Memory) remove(el *list.Element) {
+	m.lru.Remove(el)
+	delete(m.entries, el.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewMemory(2)
+	m.now = func() time.Time { return now }
+
+	get := func(key string) string {
+		b, ok, err := m.Get(ctx, key)
+		assert.NoError(t, err, key)
+		if !ok {
+			return ""
+		}
+		return string(b)
+	}
+
+	m.Set(ctx, "a", []byte("1"), time.Minute)
+	m.Set(ctx, "b", []byte("2"), time.Minute)
+	assert.Equal(t, "1", get("a"))
+	assert.Equal(t, "2", get("b"))
+	assert.Equal(t, "", get("c"), "missing")
+
+	// a is the least recently used after b is looked up
+	m.Set(ctx, "a", []byte("3"), time.Minute)
+	get("b")
+	m.Set(ctx, "c", []byte("4"), time.Minute)
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, "", get("a"), "evicted")
+	assert.Equal(t, "2", get("b"))
+	assert.Equal(t, "4", get("c"))
+
+	// expired entries are dropped when they are looked up
+	m.Set(ctx, "b", []byte("5"), 2*time.Minute)
+	now = now.Add(time.Minute)
+	assert.Equal(t, "", get("c"), "expired")
+	assert.Equal(t, 1, m.Len())
+	assert.Equal(t, "5", get("b"))
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, "", get("b"), "expired")
+	assert.Equal(t, 0, m.Len())
+
+	// deleted keys are missing, and missing keys are ignored
+	m.Set(ctx, "a", []byte("1"), time.Minute)
+	m.Set(ctx, "b", []byte("2"), time.Minute)
+	assert.NoError(t, m.Delete(ctx, "a", "missing"))
+	assert.Equal(t, "", get("a"))
+	assert.Equal(t, "2", get("b"))
+}
+
+func TestNewMemory(t *testing.T) {
+	assert.Equal(t, DefaultMemorySize, NewMemory(0).size)
+	assert.Equal(t, 5, NewMemory(5).size)
+}
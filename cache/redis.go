@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// redisTimeout is the timeout of a command when the context has no deadline
+const redisTimeout = 500 * time.Millisecond
+
+// redisPoolSize is the number of idle connections kept
+const redisPoolSize = 8
+
+// Redis is a Backend on a Redis server, or anything speaking its protocol
+// such as a local stand-in. Only GET, SET and DEL are used.
+type Redis struct {
+	addr string
+	pool chan *redisConn
+}
+
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// NewRedis returns a new Redis connecting to addr on demand
+func NewRedis(addr string) *Redis {
+	return &Redis{
+		addr: addr,
+		pool: make(chan *redisConn, redisPoolSize),
+	}
+}
+
+// Get returns the value of the key
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	v, err := r.do(ctx, "GET", []byte(key))
+	if err != nil {
+		return nil, false, err
+	}
+	if v == nil {
+		return nil, false, nil
+	}
+
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected reply to GET: %v", v)
+	}
+	return b, true, nil
+}
+
+// Set stores the value of the key for ttl, which is rounded to milliseconds
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ms := ttl.Milliseconds()
+	if ms <= 0 {
+		ms = 1
+	}
+	_, err := r.do(ctx, "SET", []byte(key), value, []byte("PX"), []byte(strconv.FormatInt(ms, 10)))
+	return err
+}
+
+// Delete removes the keys
+func (r *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	args := make([][]byte, 0, len(keys))
+	for _, k := range keys {
+		args = append(args, []byte(k))
+	}
+	_, err := r.do(ctx, "DEL", args...)
+	return err
+}
+
+// Close closes idle connections
+func (r *Redis) Close() error {
+	for {
+		select {
+		case c := <-r.pool:
+			c.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// do sends a command and reads its reply. Connections are reused only after
+// a complete reply, so that a half read reply is never taken for the next one.
+func (r *Redis) do(ctx context.Context, cmd string, args ...[]byte) (interface{}, error) {
+	c, err := r.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(redisTimeout)
+	}
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		c.conn.Close()
+		return nil, err
+	}
+
+	if _, err := c.conn.Write(encodeRedisCommand(cmd, args)); err != nil {
+		c.conn.Close()
+		return nil, err
+	}
+
+	v, err := readRedisReply(c.r)
+	if err != nil {
+		var rerr redisError
+		if !errors.As(err, &rerr) {
+			c.conn.Close()
+			return nil, err
+		}
+	}
+
+	r.put(c)
+	return v, err
+}
+
+func (r *Redis) get(ctx context.Context) (*redisConn, error) {
+	select {
+	case c := <-r.pool:
+		return c, nil
+	default:
+	}
+
+	d := net.Dialer{Timeout: redisTimeout}
+	conn, err := d.DialContext(ctx, "tcp", r.addr)
+	if err != nil {
+		return nil, err
+	}
+	return &redisConn{conn: conn, r: bufio.NewReader(conn)}, nil
+}
+
+func (r *Redis) put(c *redisConn) {
+	select {
+	case r.pool <- c:
+	default:
+		c.conn.Close()
+	}
+}
+
+// redisError is an error reply of the server
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+func encodeRedisCommand(cmd string, args [][]byte) []byte {
+	b := []byte(fmt.Sprintf("*%d\r\n$%d\r\n%s\r\n", len(args)+1, len(cmd), cmd))
+	for _, a := range args {
+		b = append(b, fmt.Sprintf("$%d\r\n", len(a))...)
+		b = append(b, a...)
+		b = append(b, "\r\n"...)
+	}
+	return b
+}
+
+// readRedisReply reads a reply. Bulk strings are returned as []byte,
+// integers as int64, simple strings as string and nil bulk strings as nil.
+func readRedisReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("malformed reply: %q", line)
+	}
+	line = line[:len(line)-2]
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	default:
+		return nil, fmt.Errorf("unsupported reply: %q", line)
+	}
+}
@@ -0,0 +1,238 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeRedisCommand(t *testing.T) {
+	got := encodeRedisCommand("SET", [][]byte{[]byte("key"), []byte("a\r\nb")})
+	assert.Equal(t, "*3\r\n$3\r\nSET\r\n$3\r\nkey\r\n$4\r\na\r\nb\r\n", string(got))
+}
+
+func TestReadRedisReply(t *testing.T) {
+	for _, tt := range []struct {
+		reply    string
+		want     interface{}
+		hasError bool
+	}{
+		{"+OK\r\n", "OK", false},
+		{":3\r\n", int64(3), false},
+		{"$5\r\nhello\r\n", []byte("hello"), false},
+		{"$4\r\na\r\nb\r\n", []byte("a\r\nb"), false},
+		{"$0\r\n\r\n", []byte{}, false},
+		{"$-1\r\n", nil, false},
+		{"-ERR wrong type\r\n", nil, true},
+		{":x\r\n", nil, true},
+		{"$x\r\n", nil, true},
+		{"$5\r\nhel", nil, true},
+		{"*1\r\n$1\r\na\r\n", nil, true},
+		{"+OK\n", nil, true},
+		{"+OK", nil, true},
+		{"", nil, true},
+	} {
+		got, err := readRedisReply(bufio.NewReader(strings.NewReader(tt.reply)))
+		if tt.hasError {
+			assert.Error(t, err, "%q", tt.reply)
+			continue
+		}
+		if assert.NoError(t, err, "%q", tt.reply) {
+			assert.Equal(t, tt.want, got, "%q", tt.reply)
+		}
+	}
+
+	_, err := readRedisReply(bufio.NewReader(strings.NewReader("-ERR wrong type\r\n")))
+	assert.Equal(t, redisError("ERR wrong type"), err)
+}
+
+// fakeRedis serves GET, SET and DEL of an in-memory map. Commands on keys
+// prefixed by "error" are answered with an error reply, and ones on keys
+// prefixed by "hang" are not answered at all.
+type fakeRedis struct {
+	lis net.Listener
+
+	mu     sync.Mutex
+	values map[string]string
+	ttls   map[string]string
+	conns  int
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	f := &fakeRedis{lis: lis, values: map[string]string{}, ttls: map[string]string{}}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			f.mu.Lock()
+			f.conns++
+			f.mu.Unlock()
+			go f.serve(conn)
+		}
+	}()
+	return f
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readFakeCommand(r)
+		if err != nil {
+			return
+		}
+
+		if len(args) > 1 && strings.HasPrefix(args[1], "hang") {
+			continue
+		}
+		if len(args) > 1 && strings.HasPrefix(args[1], "error") {
+			conn.Write([]byte("-ERR injected\r\n"))
+			continue
+		}
+
+		f.mu.Lock()
+		var reply string
+		switch args[0] {
+		case "GET":
+			v, ok := f.values[args[1]]
+			if ok {
+				reply = fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+			} else {
+				reply = "$-1\r\n"
+			}
+		case "SET":
+			f.values[args[1]] = args[2]
+			f.ttls[args[1]] = strings.Join(args[3:], " ")
+			reply = "+OK\r\n"
+		case "DEL":
+			n := 0
+			for _, k := range args[1:] {
+				if _, ok := f.values[k]; ok {
+					delete(f.values, k)
+					n++
+				}
+			}
+			reply = ":" + strconv.Itoa(n) + "\r\n"
+		default:
+			reply = "-ERR unknown command\r\n"
+		}
+		f.mu.Unlock()
+
+		conn.Write([]byte(reply))
+	}
+}
+
+func (f *fakeRedis) ttl(key string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ttls[key]
+}
+
+func (f *fakeRedis) connections() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.conns
+}
+
+// readFakeCommand reads an array of bulk strings
+func readFakeCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "*"), "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		v, err := readRedisReply(r)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(v.([]byte)))
+	}
+	return args, nil
+}
+
+func TestRedis(t *testing.T) {
+	f := newFakeRedis(t)
+	defer f.lis.Close()
+
+	ctx := context.Background()
+	r := NewRedis(f.lis.Addr().String())
+	defer r.Close()
+
+	_, ok, err := r.Get(ctx, "a")
+	if assert.NoError(t, err) {
+		assert.False(t, ok, "missing")
+	}
+
+	assert.NoError(t, r.Set(ctx, "a", []byte("1\r\n2"), 1500*time.Millisecond))
+	assert.NoError(t, r.Set(ctx, "b", []byte("3"), time.Microsecond))
+	assert.Equal(t, "PX 1500", f.ttl("a"))
+	assert.Equal(t, "PX 1", f.ttl("b"), "ttl is at least a millisecond")
+
+	b, ok, err := r.Get(ctx, "a")
+	if assert.NoError(t, err) && assert.True(t, ok) {
+		assert.Equal(t, "1\r\n2", string(b))
+	}
+
+	assert.NoError(t, r.Delete(ctx))
+	assert.NoError(t, r.Delete(ctx, "a", "missing"))
+	_, ok, err = r.Get(ctx, "a")
+	if assert.NoError(t, err) {
+		assert.False(t, ok, "deleted")
+	}
+
+	// error replies are returned, and the connection is kept
+	_, _, err = r.Get(ctx, "error")
+	assert.Equal(t, redisError("ERR injected"), err)
+	_, ok, err = r.Get(ctx, "b")
+	if assert.NoError(t, err) {
+		assert.True(t, ok)
+	}
+	assert.Equal(t, 1, f.connections())
+
+	// connections without a complete reply are not reused
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err = r.Get(ctx, "hang")
+	assert.Error(t, err)
+	_, ok, err = r.Get(context.Background(), "b")
+	if assert.NoError(t, err) {
+		assert.True(t, ok)
+	}
+	assert.Equal(t, 2, f.connections())
+}
+
+func TestRedisUnavailable(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	r := NewRedis(addr)
+	_, _, err = r.Get(context.Background(), "a")
+	assert.Error(t, err)
+	assert.Error(t, r.Set(context.Background(), "a", []byte("1"), time.Minute))
+}
@@ -135,6 +135,14 @@ func AutoMigrate(db *gorm.DB) error {
 		&model.Article{},
 		&model.Tag{},
 		&model.Comment{},
+		&model.ArticleRevision{},
+		&model.Notification{},
+		&model.Webhook{},
+		&model.WebhookDelivery{},
+		&model.OutboxEvent{},
+		&model.Bookmark{},
+		&model.Reaction{},
+		&model.Report{},
 	).Error
 	if err != nil {
 		return err
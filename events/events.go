@@ -0,0 +1,211 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/backoff"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/rs/zerolog"
+)
+
+// batchSize is the maximum number of events dispatched in a run
+const batchSize = 100
+
+const (
+	// retryDelay is the delay before retrying an event which failed once
+	retryDelay = 1 * time.Second
+	// maxRetryDelay is the maximum delay between retries of an event
+	maxRetryDelay = 10 * time.Minute
+)
+
+// Backoff returns the delay before retrying an event which failed n times.
+// The delay doubles on each failure up to maxRetryDelay.
+func Backoff(n int) time.Duration {
+	return backoff.Exponential(retryDelay, maxRetryDelay, n)
+}
+
+// Event is a domain event published to sinks
+type Event struct {
+	ID            uint            `json:"id"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   uint            `json:"aggregateId"`
+	Type          string          `json:"type"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+}
+
+func newEvent(m *model.OutboxEvent) Event {
+	return Event{
+		ID:            m.ID,
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		Type:          m.Type,
+		Payload:       json.RawMessage(m.Payload),
+		OccurredAt:    m.CreatedAt,
+	}
+}
+
+// Sink receives dispatched events. The same event may be published
+// more than once, so sinks or their consumers should deduplicate by ID.
+type Sink interface {
+	Publish(ctx context.Context, e Event) error
+}
+
+// Outbox stores events waiting to be dispatched
+type Outbox interface {
+	GetPendingEvents(t time.Time, limit int64) ([]model.OutboxEvent, error)
+	MarkDispatched(m *model.OutboxEvent, t time.Time) error
+	MarkFailed(m *model.OutboxEvent, next time.Time) error
+	DeleteDispatchedEvents(t time.Time) (int64, error)
+}
+
+// Dispatcher publishes events in the outbox to sinks
+type Dispatcher struct {
+	logger *zerolog.Logger
+	outbox Outbox
+	sinks  []Sink
+}
+
+// NewDispatcher returns a new Dispatcher
+func NewDispatcher(l *zerolog.Logger, o Outbox) *Dispatcher {
+	return &Dispatcher{logger: l, outbox: o}
+}
+
+// AddSink registers a sink
+func (d *Dispatcher) AddSink(s Sink) {
+	d.sinks = append(d.sinks, s)
+}
+
+// Dispatch publishes pending events to every sink in the order they occurred.
+// An event is marked as dispatched only after all sinks accepted it, so it is
+// delivered at least once. When an event fails, it is retried with backoff
+// and later events of the same aggregate are held back to keep them in order,
+// while events of other aggregates go on.
+func (d *Dispatcher) Dispatch(now time.Time) error {
+	es, err := d.outbox.GetPendingEvents(now, batchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get pending events: %w", err)
+	}
+
+	blocked := map[string]bool{}
+	failed := 0
+	for i := range es {
+		m := &es[i]
+
+		key := fmt.Sprintf("%s/%d", m.AggregateType, m.AggregateID)
+		if blocked[key] {
+			continue
+		}
+
+		if err := d.publish(newEvent(m)); err != nil {
+			d.logger.Error().Err(err).
+				Uint("event_id", m.ID).
+				Str("type", m.Type).
+				Int("attempts", m.Attempts+1).
+				Msg("failed to publish event")
+			blocked[key] = true
+			failed++
+
+			if err := d.outbox.MarkFailed(m, now.Add(Backoff(m.Attempts+1))); err != nil {
+				return fmt.Errorf("failed to mark event failed: %w", err)
+			}
+			continue
+		}
+
+		if err := d.outbox.MarkDispatched(m, now); err != nil {
+			return fmt.Errorf("failed to mark event dispatched: %w", err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to publish %d events", failed)
+	}
+
+	return nil
+}
+
+// Prune deletes events dispatched before t, which are kept for a while
+// to investigate deliveries
+func (d *Dispatcher) Prune(t time.Time) (int64, error) {
+	n, err := d.outbox.DeleteDispatchedEvents(t)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune dispatched events: %w", err)
+	}
+	return n, nil
+}
+
+func (d *Dispatcher) publish(e Event) error {
+	for _, s := range d.sinks {
+		if err := s.Publish(context.Background(), e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MemorySink keeps published events in memory
+type MemorySink struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// NewMemorySink returns a new MemorySink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+// Publish keeps the event
+func (s *MemorySink) Publish(ctx context.Context, e Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, e)
+	return nil
+}
+
+// Events returns published events
+func (s *MemorySink) Events() []Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Event{}, s.events...)
+}
+
+// FileSink appends events to a file as newline delimited JSON
+type FileSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// NewFileSink returns a new FileSink appending to the file at path
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{f: f}, nil
+}
+
+// Publish appends the event as a line
+func (s *FileSink) Publish(ctx context.Context, e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.f.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	return s.f.Sync()
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	return s.f.Close()
+}
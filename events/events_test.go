@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryOutbox is an outbox in memory, which returns every due event
+type memoryOutbox struct {
+	events []*model.OutboxEvent
+}
+
+func (o *memoryOutbox) GetPendingEvents(t time.Time, limit int64) ([]model.OutboxEvent, error) {
+	var es []model.OutboxEvent
+	for _, e := range o.events {
+		if e.DispatchedAt == nil && (e.NextAttemptAt == nil || !e.NextAttemptAt.After(t)) {
+			es = append(es, *e)
+		}
+	}
+	return es, nil
+}
+
+func (o *memoryOutbox) find(id uint) *model.OutboxEvent {
+	for _, e := range o.events {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+func (o *memoryOutbox) MarkDispatched(m *model.OutboxEvent, t time.Time) error {
+	o.find(m.ID).DispatchedAt = &t
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(m *model.OutboxEvent, next time.Time) error {
+	e := o.find(m.ID)
+	e.Attempts++
+	e.NextAttemptAt = &next
+	return nil
+}
+
+func (o *memoryOutbox) DeleteDispatchedEvents(t time.Time) (int64, error) {
+	var kept []*model.OutboxEvent
+	for _, e := range o.events {
+		if e.DispatchedAt == nil || !e.DispatchedAt.Before(t) {
+			kept = append(kept, e)
+		}
+	}
+	n := len(o.events) - len(kept)
+	o.events = kept
+	return int64(n), nil
+}
+
+// failingSink fails to publish events of the aggregate
+type failingSink struct {
+	aggregateID uint
+}
+
+func (s *failingSink) Publish(ctx context.Context, e Event) error {
+	if e.AggregateID == s.aggregateID {
+		return errors.New("failure")
+	}
+	return nil
+}
+
+func TestBackoff(t *testing.T) {
+	for _, tt := range []struct {
+		n    int
+		want time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{5, 16 * time.Second},
+		{100, maxRetryDelay},
+	} {
+		assert.Equal(t, tt.want, Backoff(tt.n), tt.n)
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	l := zerolog.Nop()
+	o := &memoryOutbox{events: []*model.OutboxEvent{
+		{ID: 1, AggregateType: model.AggregateArticle, AggregateID: 1, Type: model.EventArticleCreated},
+		{ID: 2, AggregateType: model.AggregateArticle, AggregateID: 2, Type: model.EventArticleCreated},
+	}}
+	d := NewDispatcher(&l, o)
+	ms := NewMemorySink()
+	d.AddSink(&failingSink{aggregateID: 1})
+	d.AddSink(ms)
+
+	now := time.Now()
+	assert.Error(t, d.Dispatch(now))
+	assert.Len(t, ms.Events(), 1, "events of other aggregates go on")
+
+	failed := o.find(1)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, now.Add(Backoff(1)), *failed.NextAttemptAt)
+
+	// not retried before backoff
+	assert.NoError(t, d.Dispatch(now))
+	assert.Equal(t, 1, o.find(1).Attempts)
+
+	assert.Error(t, d.Dispatch(now.Add(Backoff(1))))
+	assert.Equal(t, 2, o.find(1).Attempts)
+	assert.Equal(t, now.Add(Backoff(1)+Backoff(2)), *o.find(1).NextAttemptAt)
+
+	// only dispatched events before the time are pruned
+	n, err := d.Prune(now.Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	assert.Nil(t, o.find(2))
+	assert.NotNil(t, o.find(1))
+}
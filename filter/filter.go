@@ -0,0 +1,269 @@
+package filter
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Action is what to do with filtered content
+type Action int
+
+// actions ordered from the least to the most strict
+const (
+	Allow Action = iota
+	Hold
+	Reject
+)
+
+// String returns the name of the action
+func (a Action) String() string {
+	switch a {
+	case Allow:
+		return "allow"
+	case Hold:
+		return "hold"
+	case Reject:
+		return "reject"
+	}
+	return fmt.Sprintf("action(%d)", int(a))
+}
+
+// kinds of filtered content
+const (
+	KindArticle = "article"
+	KindComment = "comment"
+	KindBio     = "bio"
+)
+
+// Content is user generated text to be filtered before it is persisted
+type Content struct {
+	Kind   string
+	UserID uint
+	Text   string
+}
+
+// Decision is the outcome of a filter
+type Decision struct {
+	Action Action
+	Filter string
+	Reason string
+}
+
+// Filter inspects content before it is persisted
+type Filter interface {
+	Check(ctx context.Context, c Content) (Decision, error)
+}
+
+// allow is the decision of content no filter objects to
+var allow = Decision{Action: Allow}
+
+// Chain runs filters in order and returns the strictest decision.
+// It stops at the first rejection.
+type Chain []Filter
+
+// Check runs the filters of the chain
+func (ch Chain) Check(ctx context.Context, c Content) (Decision, error) {
+	d := allow
+	for _, f := range ch {
+		fd, err := f.Check(ctx, c)
+		if err != nil {
+			return Decision{}, err
+		}
+		if fd.Action > d.Action {
+			d = fd
+		}
+		if d.Action == Reject {
+			break
+		}
+	}
+	return d, nil
+}
+
+// ParseList parses a comma separated list, ignoring blank items
+func ParseList(s string) []string {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}']+`)
+
+// WordList takes the action on content which contains any of the words
+type WordList struct {
+	words  map[string]bool
+	action Action
+}
+
+// NewWordList returns a new WordList. Words are matched case-insensitively.
+func NewWordList(words []string, action Action) *WordList {
+	m := make(map[string]bool, len(words))
+	for _, w := range words {
+		m[strings.ToLower(w)] = true
+	}
+	return &WordList{words: m, action: action}
+}
+
+// Check checks whether the content contains any of the words
+func (f *WordList) Check(ctx context.Context, c Content) (Decision, error) {
+	if len(f.words) == 0 {
+		return allow, nil
+	}
+
+	for _, w := range wordPattern.FindAllString(strings.ToLower(c.Text), -1) {
+		if f.words[w] {
+			return Decision{
+				Action: f.action,
+				Filter: "words",
+				Reason: fmt.Sprintf("contains %q", w),
+			}, nil
+		}
+	}
+	return allow, nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s<>()"'\]]+`)
+
+// Links rejects content linking to blocked hosts and holds content with too many links
+type Links struct {
+	maxLinks     int
+	blockedHosts []string
+}
+
+// NewLinks returns a new Links. Zero maxLinks allows any number of links.
+// Subdomains of the blocked hosts are blocked as well.
+func NewLinks(maxLinks int, blockedHosts []string) *Links {
+	hosts := make([]string, 0, len(blockedHosts))
+	for _, h := range blockedHosts {
+		hosts = append(hosts, strings.ToLower(h))
+	}
+	return &Links{maxLinks: maxLinks, blockedHosts: hosts}
+}
+
+// Check checks links of the content
+func (f *Links) Check(ctx context.Context, c Content) (Decision, error) {
+	links := linkPattern.FindAllString(c.Text, -1)
+
+	for _, l := range links {
+		u, err := url.Parse(l)
+		if err != nil {
+			continue
+		}
+		host := strings.ToLower(u.Hostname())
+		for _, b := range f.blockedHosts {
+			if host == b || strings.HasSuffix(host, "."+b) {
+				return Decision{
+					Action: Reject,
+					Filter: "links",
+					Reason: fmt.Sprintf("links to blocked host %s", host),
+				}, nil
+			}
+		}
+	}
+
+	if f.maxLinks > 0 && len(links) > f.maxLinks {
+		return Decision{
+			Action: Hold,
+			Filter: "links",
+			Reason: fmt.Sprintf("contains %d links", len(links)),
+		}, nil
+	}
+	return allow, nil
+}
+
+// Duplicates holds content the same user already posted within the window.
+// It remembers posts in memory, so duplicates are detected per process.
+// Posts out of the window are forgotten for every user once per window, so
+// that users who post once are not remembered forever.
+type Duplicates struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu     sync.Mutex
+	posts  map[uint]map[[sha256.Size]byte]time.Time
+	pruned time.Time
+}
+
+// NewDuplicates returns a new Duplicates
+func NewDuplicates(window time.Duration) *Duplicates {
+	return &Duplicates{
+		window: window,
+		now:    time.Now,
+		posts:  map[uint]map[[sha256.Size]byte]time.Time{},
+	}
+}
+
+// Check checks whether the user posted the same content recently and remembers the content
+func (f *Duplicates) Check(ctx context.Context, c Content) (Decision, error) {
+	// bios are not posts
+	if c.Kind == KindBio || f.window <= 0 {
+		return allow, nil
+	}
+
+	text := strings.Join(strings.Fields(strings.ToLower(c.Text)), " ")
+	if text == "" {
+		return allow, nil
+	}
+	key := sha256.Sum256([]byte(c.Kind + "\x00" + text))
+	now := f.now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if now.Sub(f.pruned) > f.window {
+		for id, posts := range f.posts {
+			f.forget(id, posts, now)
+		}
+		f.pruned = now
+	}
+
+	posts := f.posts[c.UserID]
+	if posts != nil {
+		f.forget(c.UserID, posts, now)
+	}
+	if posts = f.posts[c.UserID]; posts == nil {
+		posts = map[[sha256.Size]byte]time.Time{}
+		f.posts[c.UserID] = posts
+	}
+
+	_, dup := posts[key]
+	posts[key] = now
+	if dup {
+		return Decision{
+			Action: Hold,
+			Filter: "duplicates",
+			Reason: fmt.Sprintf("posted the same %s within %s", c.Kind, f.window),
+		}, nil
+	}
+	return allow, nil
+}
+
+// Len returns the number of users whose posts are remembered
+func (f *Duplicates) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.posts)
+}
+
+// forget forgets posts of the user out of the window, and the user if none is left
+func (f *Duplicates) forget(userID uint, posts map[[sha256.Size]byte]time.Time, now time.Time) {
+	for k, t := range posts {
+		if now.Sub(t) > f.window {
+			delete(posts, k)
+		}
+	}
+	if len(posts) == 0 {
+		delete(f.posts, userID)
+	}
+}
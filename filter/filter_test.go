@@ -0,0 +1,46 @@
+package filter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuplicates(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	f := NewDuplicates(time.Minute)
+	f.now = func() time.Time { return now }
+
+	check := func(userID uint, text string) Action {
+		d, err := f.Check(ctx, Content{Kind: KindArticle, UserID: userID, Text: text})
+		assert.NoError(t, err)
+		return d.Action
+	}
+
+	assert.Equal(t, Allow, check(1, "hello world"))
+	assert.Equal(t, Hold, check(1, "Hello  World"), "same text")
+	assert.Equal(t, Allow, check(2, "hello world"), "other user")
+	assert.Equal(t, Allow, check(1, "hello"), "other text")
+	assert.Equal(t, 2, f.Len())
+
+	d, err := f.Check(ctx, Content{Kind: KindComment, UserID: 1, Text: "hello world"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Allow, d.Action, "other kind")
+	}
+
+	d, err = f.Check(ctx, Content{Kind: KindBio, UserID: 3, Text: "hello world"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Allow, d.Action)
+	}
+	assert.Equal(t, 2, f.Len(), "bios are not remembered")
+
+	// posts out of the window are forgotten
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, Allow, check(1, "hello world"))
+
+	// users who do not post again are forgotten as well
+	assert.Equal(t, 1, f.Len())
+}
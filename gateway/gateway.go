@@ -2,32 +2,82 @@ package main
 
 import (
 	"flag"
+	"io"
 	"log"
 	"net/http"
+	"net/textproto"
+	"regexp"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/raahii/golang-grpc-realworld-example/health"
+	"github.com/raahii/golang-grpc-realworld-example/logging"
+	"github.com/raahii/golang-grpc-realworld-example/metrics"
 	gw "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
 )
 
 var (
 	echoEndpoint = flag.String("endpoint", "localhost:50051", "endpoint of YourService")
+	adminAddr    = flag.String("admin", ":9091", "address of the admin server serving metrics and health probes")
 )
 
+// uploadChunkSize is the size of chunks streamed to the image service
+const uploadChunkSize = 32 << 10
+
+// maxUploadSize limits the size of multipart request bodies,
+// leaving room for the multipart envelope around the image
+const maxUploadSize = 6 << 20
+
+// privateCachePolicy is the Cache-Control policy of personal responses, which
+// may be kept by browsers only and must be revalidated with their ETags
+const privateCachePolicy = "private, no-cache"
+
+// cachePolicies are Cache-Control policies of GET routes, the first match wins.
+// Public policies fall back to privateCachePolicy for authenticated requests,
+// as the responses have the state of the current user.
+var cachePolicies = []struct {
+	pattern *regexp.Regexp
+	policy  string
+}{
+	{regexp.MustCompile(`^/user/export$`), "no-store"},
+	{regexp.MustCompile(`^/tags$`), "public, max-age=300"},
+	{regexp.MustCompile(`^/images/[^/]+$`), "public, max-age=86400"},
+	{regexp.MustCompile(`^/articles/(feed|drafts|bookmarks|trash)(/|$)`), privateCachePolicy},
+	{regexp.MustCompile(`^/articles/[^/]+/(revisions(/|$)|comments/watch$)`), privateCachePolicy},
+	{regexp.MustCompile(`^/articles(/[^/]+(/comments(/[^/]+)?)?(/reactions/[^/]+)?)?$`), "public, max-age=60"},
+	{regexp.MustCompile(`^/profiles/[^/]+(/followers|/following)?$`), "public, max-age=60"},
+}
+
 func run() error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	ropts := []runtime.ServeMuxOption{
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{OrigName: true, EmitDefaults: true},
+		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
 	}
+	runtime.HTTPError = httpError
 
 	mux := runtime.NewServeMux(ropts...)
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
 
 	// users
 	err := gw.RegisterUsersHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
@@ -41,14 +91,360 @@ func run() error {
 		return err
 	}
 
+	// images
+	err = gw.RegisterImagesHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	// notifications
+	err = gw.RegisterNotificationsHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	// webhooks
+	err = gw.RegisterWebhooksHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	// moderation
+	err = gw.RegisterModerationHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.DialContext(ctx, *echoEndpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// multipart uploads and binary downloads are not supported by grpc-gateway,
+	// so forward them manually
+	hmux := http.NewServeMux()
+	hmux.Handle("/", mux)
+	hmux.Handle("/images", uploadImage(mux, gw.NewImagesClient(conn)))
+	hmux.Handle("/user/export", exportMyData(mux, gw.NewUsersClient(conn)))
+
+	// metrics and probes are served apart from the public API
+	admin := http.NewServeMux()
+	admin.Handle("/metrics", metrics.Handler())
+	admin.Handle("/healthz", health.LivenessHandler())
+	admin.Handle("/readyz", health.ReadinessHandler(healthpb.NewHealthClient(conn)))
+	go func() {
+		log.Printf("starting admin server on %s", *adminAddr)
+		if err := http.ListenAndServe(*adminAddr, admin); err != nil {
+			log.Printf("admin server stopped: %v", err)
+		}
+	}()
+
 	log.Println("starting gateway server on port 3000")
-	return http.ListenAndServe(":3000", mux)
+	// spans of requests are named by their routes, and continue traces of clients
+	traced := otelhttp.NewHandler(conditional(hmux), "gateway",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + metrics.Route(r.URL.Path)
+		}),
+	)
+	return http.ListenAndServe(":3000", metrics.Middleware(traced))
+}
+
+// incomingHeader passes If-Match to the server as a precondition on entity tags,
+// and X-Request-Id to correlate logs of the request
+func incomingHeader(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case "If-Match":
+		return "if-match", true
+	case "X-Request-Id":
+		return logging.RequestIDKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader returns entity tags of the server as ETag, and request IDs as X-Request-Id
+func outgoingHeader(key string) (string, bool) {
+	switch key {
+	case "etag":
+		return "ETag", true
+	case logging.RequestIDKey:
+		return "X-Request-Id", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// httpError returns failed If-Match preconditions as 412 Precondition Failed
+func httpError(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.FailedPrecondition && r.Header.Get("If-Match") != "" {
+		w = &statusWriter{ResponseWriter: w, status: http.StatusPreconditionFailed}
+	}
+	runtime.DefaultHTTPError(ctx, mux, m, w, r, err)
+}
+
+// statusWriter overwrites the status code of the response
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// conditional sets Cache-Control of GET requests, and answers them with
+// 304 Not Modified when If-None-Match has the ETag of the response.
+// ETags are dropped from responses of other methods, which are not representations.
+func conditional(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cw := &conditionalWriter{ResponseWriter: w}
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			cw.get = true
+			cw.ifNoneMatch = r.Header.Get("If-None-Match")
+			w.Header().Set("Cache-Control", cachePolicy(r))
+			w.Header().Add("Vary", "Authorization")
+		}
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// cachePolicy returns the Cache-Control policy of the GET request
+func cachePolicy(r *http.Request) string {
+	for _, p := range cachePolicies {
+		if !p.pattern.MatchString(r.URL.Path) {
+			continue
+		}
+		if strings.HasPrefix(p.policy, "public") && r.Header.Get("Authorization") != "" {
+			return privateCachePolicy
+		}
+		return p.policy
+	}
+	return privateCachePolicy
+}
+
+// matchETag returns whether the list of entity tags in If-None-Match has the tag,
+// with the weak comparison. "*" matches any tag.
+func matchETag(list, tag string) bool {
+	if tag == "" {
+		return false
+	}
+
+	tag = strings.TrimPrefix(tag, "W/")
+	for _, t := range strings.Split(list, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || strings.TrimPrefix(t, "W/") == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionalWriter replaces successful responses with 304 Not Modified
+// if the client has them already
+type conditionalWriter struct {
+	http.ResponseWriter
+	get         bool
+	ifNoneMatch string
+
+	wroteHeader bool
+	notModified bool
+}
+
+func (w *conditionalWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	h := w.Header()
+	if !w.get {
+		h.Del("ETag")
+	} else if code == http.StatusOK && w.ifNoneMatch != "" && matchETag(w.ifNoneMatch, h.Get("ETag")) {
+		h.Del("Content-Type")
+		h.Del("Content-Length")
+		w.notModified = true
+		code = http.StatusNotModified
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *conditionalWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush keeps streaming responses working through the writer
+func (w *conditionalWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok && !w.notModified {
+		f.Flush()
+	}
+}
+
+// uploadImage streams the "image" field of a multipart form to the image service
+func uploadImage(mux *runtime.ServeMux, client gw.ImagesClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		if r.Method != http.MethodPost {
+			runtime.OtherErrorHandler(w, r, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+		mr, err := r.MultipartReader()
+		if err != nil {
+			runtime.OtherErrorHandler(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var part io.Reader
+		var contentType string
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				runtime.OtherErrorHandler(w, r, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if p.FormName() == "image" {
+				part, contentType = p, p.Header.Get("Content-Type")
+				break
+			}
+		}
+		if part == nil {
+			runtime.OtherErrorHandler(w, r, "image is required", http.StatusBadRequest)
+			return
+		}
+
+		ctx := outgoingContext(r)
+
+		stream, err := client.UploadImage(ctx)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		buf := make([]byte, uploadChunkSize)
+		first := true
+		for {
+			n, rerr := part.Read(buf)
+			if n > 0 {
+				req := &gw.UploadImageRequest{Chunk: buf[:n]}
+				if first {
+					req.ContentType = contentType
+					first = false
+				}
+				// io.EOF means the server closed the stream, its status is returned by CloseAndRecv
+				if err := stream.Send(req); err != nil {
+					break
+				}
+			}
+			if rerr == io.EOF {
+				break
+			}
+			if rerr != nil {
+				runtime.OtherErrorHandler(w, r, rerr.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		b, err := outbound.Marshal(resp)
+		if err != nil {
+			runtime.OtherErrorHandler(w, r, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", outbound.ContentType())
+		w.WriteHeader(http.StatusCreated)
+		w.Write(b)
+	}
+}
+
+// outgoingContext returns the context of the request which forwards
+// its credentials and request ID to the server
+func outgoingContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if a := r.Header.Get("Authorization"); a != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", a)
+	}
+	if id := r.Header.Get("X-Request-Id"); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, logging.RequestIDKey, id)
+	}
+	return ctx
+}
+
+// exportMyData streams the data export archive of the user as a ZIP download
+func exportMyData(mux *runtime.ServeMux, client gw.UsersClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		if r.Method != http.MethodGet {
+			runtime.OtherErrorHandler(w, r, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx := outgoingContext(r)
+
+		stream, err := client.ExportMyData(ctx, &gw.Empty{})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		// errors before the first chunk, such as authentication, are still returned as statuses
+		resp, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		f, _ := w.(http.Flusher)
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="export.zip"`)
+		w.WriteHeader(http.StatusOK)
+		for {
+			if _, err := w.Write(resp.GetChunk()); err != nil {
+				return
+			}
+			if f != nil {
+				f.Flush()
+			}
+
+			resp, err = stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				// the response is already started, so the client gets a truncated archive
+				log.Printf("failed to receive export archive: %v", err)
+				return
+			}
+		}
+	}
 }
 
 func main() {
 	flag.Parse()
 	defer glog.Flush()
 
+	shutdown, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv("realworld-gateway"))
+	if err != nil {
+		glog.Fatal(err)
+	}
+	defer shutdown(context.Background())
+
 	if err := run(); err != nil {
 		glog.Fatal(err)
 	}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachePolicy(t *testing.T) {
+	for _, tt := range []struct {
+		path string
+		auth bool
+		want string
+	}{
+		{"/tags", false, "public, max-age=300"},
+		{"/tags", true, privateCachePolicy},
+		{"/articles", false, "public, max-age=60"},
+		{"/articles/1", false, "public, max-age=60"},
+		{"/articles/1/comments", false, "public, max-age=60"},
+		{"/articles/feed", false, privateCachePolicy},
+		{"/articles/1/revisions", false, privateCachePolicy},
+		{"/profiles/foo/followers", false, "public, max-age=60"},
+		{"/images/a.png", false, "public, max-age=86400"},
+		{"/user/export", true, "no-store"},
+		{"/user", true, privateCachePolicy},
+	} {
+		r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.auth {
+			r.Header.Set("Authorization", "Token secret")
+		}
+		assert.Equal(t, tt.want, cachePolicy(r), "%s (auth=%v)", tt.path, tt.auth)
+	}
+}
+
+func TestMatchETag(t *testing.T) {
+	for _, tt := range []struct {
+		list string
+		tag  string
+		want bool
+	}{
+		{`"a"`, `"a"`, true},
+		{`"b", "a"`, `"a"`, true},
+		{`W/"a"`, `"a"`, true},
+		{`"a"`, `W/"a"`, true},
+		{`"b"`, `"a"`, false},
+		{`*`, `"a"`, true},
+		{`*`, "", false},
+	} {
+		assert.Equal(t, tt.want, matchETag(tt.list, tt.tag), "%s %s", tt.list, tt.tag)
+	}
+}
+
+func TestConditional(t *testing.T) {
+	h := conditional(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"a"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+
+	for _, tt := range []struct {
+		title       string
+		method      string
+		ifNoneMatch string
+		code        int
+		etag        string
+		body        string
+	}{
+		{"get without precondition", http.MethodGet, "", http.StatusOK, `"a"`, `{}`},
+		{"get with current tag", http.MethodGet, `"a"`, http.StatusNotModified, `"a"`, ""},
+		{"get with stale tag", http.MethodGet, `"b"`, http.StatusOK, `"a"`, `{}`},
+		{"put drops tag", http.MethodPut, `"a"`, http.StatusOK, "", `{}`},
+	} {
+		r := httptest.NewRequest(tt.method, "/articles/1", nil)
+		if tt.ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", tt.ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, tt.code, w.Code, tt.title)
+		assert.Equal(t, tt.etag, w.Header().Get("ETag"), tt.title)
+		assert.Equal(t, tt.body, w.Body.String(), tt.title)
+	}
+}
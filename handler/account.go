@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// what happens to articles and comments of users who delete their accounts
+const (
+	// AccountDeletionAnonymize keeps the contents as ones by a deleted user
+	AccountDeletionAnonymize = "anonymize"
+	// AccountDeletionRemove deletes the contents permanently
+	AccountDeletionRemove = "remove"
+)
+
+// DeleteAccount deletes current user's account after confirming the password
+func (h *Handler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.Empty, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !currentUser.CheckPassword(req.GetPassword()) {
+		h.logger.Error().Uint("user_id", currentUser.ID).Msg("failed to delete account due to wrong password")
+		return nil, status.Error(codes.InvalidArgument, "invalid password")
+	}
+
+	if err := h.us.DeleteAccount(currentUser, h.cfg.AccountDeletionMode == AccountDeletionRemove); err != nil {
+		msg := "failed to delete account"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	h.logger.Info().Uint("user_id", currentUser.ID).Str("mode", h.cfg.AccountDeletionMode).Msg("account deleted")
+
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	for _, mode := range []string{AccountDeletionAnonymize, AccountDeletionRemove} {
+		testDeleteAccount(t, mode)
+	}
+}
+
+func testDeleteAccount(t *testing.T, mode string) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	h.cfg.AccountDeletionMode = mode
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := u.HashPassword(); err != nil {
+			t.Fatal(err)
+		}
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	slugs := map[string]string{}
+	for _, name := range []string{"foo", "bar"} {
+		resp, err := h.CreateArticle(ctxs[name], &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       name,
+				Description: "description",
+				Body:        name,
+				TagList:     []string{name},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs[name] = resp.GetArticle().GetSlug()
+	}
+
+	// foo and bar interact with each other
+	for _, f := range [][2]string{{"foo", "bar"}, {"bar", "foo"}} {
+		from, to := f[0], f[1]
+		if _, err := h.FollowUser(ctxs[from], &pb.FollowRequest{Username: to}); err != nil {
+			t.Fatalf("failed to follow user: %v", err)
+		}
+		if _, err := h.FavoriteArticle(ctxs[from], &pb.FavoriteArticleRequest{Slug: slugs[to]}); err != nil {
+			t.Fatalf("failed to favorite article: %v", err)
+		}
+		if _, err := h.CreateComment(ctxs[from], &pb.CreateCommentRequest{
+			Slug:    slugs[to],
+			Comment: &pb.CreateCommentRequest_Comment{Body: "comment by " + from},
+		}); err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+	}
+
+	if _, err := h.BookmarkArticle(ctxs["foo"], &pb.BookmarkArticleRequest{Slug: slugs["bar"]}); err != nil {
+		t.Fatalf("failed to bookmark article: %v", err)
+	}
+	if _, err := h.AddArticleReaction(ctxs["foo"], &pb.AddArticleReactionRequest{Slug: slugs["bar"], Kind: "like"}); err != nil {
+		t.Fatalf("failed to react to article: %v", err)
+	}
+	if _, err := h.MuteUser(ctxs["foo"], &pb.MuteRequest{Username: "bar"}); err != nil {
+		t.Fatalf("failed to mute user: %v", err)
+	}
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		password string
+		hasError bool
+	}{
+		{"delete account: unauthenticated", context.Background(), "secret", true},
+		{"delete account: wrong password", ctxs["foo"], "wrong", true},
+		{"delete account: success", ctxs["foo"], "secret", false},
+		{"delete account twice: unauthenticated", ctxs["foo"], "secret", true},
+	}
+
+	for _, tt := range tests {
+		_, err := h.DeleteAccount(tt.ctx, &pb.DeleteAccountRequest{Password: tt.password})
+		if tt.hasError {
+			assert.Error(t, err, "%s (%s)", tt.title, mode)
+		} else {
+			assert.NoError(t, err, "%s (%s)", tt.title, mode)
+		}
+	}
+
+	// tokens are revoked and the user cannot login anymore
+	_, err := h.AuthFunc(ctxs["foo"])
+	assert.Error(t, err, mode)
+
+	_, err = h.CurrentUser(ctxs["foo"], &pb.Empty{})
+	assert.Error(t, err, mode)
+
+	_, err = h.LoginUser(context.Background(), &pb.LoginUserRequest{
+		User: &pb.LoginUserRequest_User{Email: "foo@example.com", Password: "secret"},
+	})
+	assert.Error(t, err, mode)
+
+	// the profile is anonymized
+	_, err = h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "foo"})
+	assert.Error(t, err, mode)
+
+	u, err := h.us.GetByID(fooUser.ID)
+	if assert.NoError(t, err, mode) {
+		assert.True(t, u.IsAnonymized(), mode)
+		assert.NotEqual(t, "foo", u.Username, mode)
+		assert.NotEqual(t, "foo@example.com", u.Email, mode)
+		assert.False(t, u.CheckPassword("secret"), mode)
+	}
+
+	// follows, favorites, bookmarks, reactions, mutes and notifications are cleaned up
+	profile, err := h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "bar"})
+	if assert.NoError(t, err, mode) {
+		assert.Equal(t, int32(0), profile.GetProfile().GetFollowersCount(), mode)
+		assert.Equal(t, int32(0), profile.GetProfile().GetFollowingCount(), mode)
+		assert.Equal(t, int32(0), profile.GetProfile().GetFavoritesCount(), mode)
+	}
+
+	article, err := h.GetArticle(ctxs["bar"], &pb.GetArticleRequest{Slug: slugs["bar"]})
+	if assert.NoError(t, err, mode) {
+		assert.Equal(t, int32(0), article.GetArticle().GetFavoritesCount(), mode)
+		assert.Empty(t, reactionCounts(article.GetArticle().GetReactions()), mode)
+	}
+
+	barArticleID, err := strconv.Atoi(slugs["bar"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	barArticle := model.Article{}
+	barArticle.ID = uint(barArticleID)
+
+	bookmarked, err := h.as.IsBookmarked(&barArticle, &fooUser)
+	if assert.NoError(t, err, mode) {
+		assert.False(t, bookmarked, mode)
+	}
+
+	muting, err := h.us.IsMuting(&fooUser, &barUser)
+	if assert.NoError(t, err, mode) {
+		assert.False(t, muting, mode)
+	}
+
+	notifications, err := h.ListNotifications(ctxs["bar"], &pb.ListNotificationsRequest{})
+	if assert.NoError(t, err, mode) {
+		assert.Empty(t, notifications.GetNotifications(), mode)
+	}
+
+	// contents are kept as ones by a deleted user, or removed
+	comments, err := h.GetComments(ctxs["bar"], &pb.GetCommentsRequest{Slug: slugs["bar"]})
+	if !assert.NoError(t, err, mode) {
+		return
+	}
+
+	fooArticle, err := h.GetArticle(ctxs["bar"], &pb.GetArticleRequest{Slug: slugs["foo"]})
+	switch mode {
+	case AccountDeletionAnonymize:
+		if assert.NoError(t, err, mode) {
+			assert.Equal(t, model.DeletedUsername, fooArticle.GetArticle().GetAuthor().GetUsername())
+			assert.Equal(t, int32(1), fooArticle.GetArticle().GetFavoritesCount())
+		}
+		if assert.Len(t, comments.GetComments(), 1, mode) {
+			assert.Equal(t, model.DeletedUsername, comments.GetComments()[0].GetAuthor().GetUsername())
+		}
+	case AccountDeletionRemove:
+		assert.Error(t, err, mode)
+		assert.Empty(t, comments.GetComments(), mode)
+
+		fooArticleID, err := strconv.Atoi(slugs["foo"])
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = h.as.GetDeletedArticleByID(uint(fooArticleID))
+		assert.Error(t, err, "article is not left in the trash")
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/filter"
+	"github.com/raahii/golang-grpc-realworld-example/metrics"
 	"github.com/raahii/golang-grpc-realworld-example/model"
 	pb "github.com/raahii/golang-grpc-realworld-example/proto"
 	"google.golang.org/grpc/codes"
@@ -14,7 +18,7 @@ import (
 
 // CreateArticle creates a article
 func (h *Handler) CreateArticle(ctx context.Context, req *pb.CreateAritcleRequest) (*pb.ArticleResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("create article")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -40,6 +44,26 @@ func (h *Handler) CreateArticle(ctx context.Context, req *pb.CreateAritcleReques
 		Body:        ra.GetBody(),
 		Author:      *currentUser,
 		Tags:        tags,
+		Status:      ra.GetStatus(),
+	}
+
+	if ra.GetPublishAt() != "" {
+		publishAt, err := parseTime(ra.GetPublishAt())
+		if err != nil {
+			msg := "invalid publishAt"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.InvalidArgument, msg)
+		}
+		article.PublishAt = &publishAt
+	}
+
+	switch article.Status {
+	case "", model.ArticleStatusPublished:
+		article.Publish(time.Now())
+	case model.ArticleStatusScheduled:
+		if article.PublishAt != nil && !article.PublishAt.After(time.Now()) {
+			article.Publish(time.Now())
+		}
 	}
 
 	err = article.Validate()
@@ -49,6 +73,23 @@ func (h *Handler) CreateArticle(ctx context.Context, req *pb.CreateAritcleReques
 		return nil, status.Error(codes.InvalidArgument, msg)
 	}
 
+	err = article.RenderBody()
+	if err != nil {
+		msg := "failed to render article body"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	decision, err := h.filterContent(ctx, filter.KindArticle, currentUser, article.Text())
+	if err != nil {
+		return nil, err
+	}
+	held := decision.Action == filter.Hold
+	if held {
+		now := time.Now()
+		article.HiddenAt = &now
+	}
+
 	err = h.as.Create(&article)
 	if err != nil {
 		msg := "Failed to create user."
@@ -56,6 +97,22 @@ func (h *Handler) CreateArticle(ctx context.Context, req *pb.CreateAritcleReques
 		return nil, status.Error(codes.Canceled, msg)
 	}
 
+	metrics.ArticlesCreated.Inc()
+
+	// held articles are announced only after staff review them
+	if held {
+		if err := h.holdContent(model.ReportTargetArticle, article.ID, decision); err != nil {
+			h.logger.Error().Err(err).Str("target_type", model.ReportTargetArticle).Uint("target_id", article.ID).
+				Msg("failed to hold content")
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+	} else {
+		if article.IsPublished() {
+			h.publishArticle(&article)
+		}
+		h.triggerArticleWebhooks(model.EventArticleCreated, &article, nil)
+	}
+
 	// get whether the article is current user's favorite
 	favorited := true
 	pa := article.ProtoArticle(favorited)
@@ -74,7 +131,7 @@ func (h *Handler) CreateArticle(ctx context.Context, req *pb.CreateAritcleReques
 
 // GetArticle gets a article
 func (h *Handler) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*pb.ArticleResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("get article")
+	h = h.withContext(ctx)
 
 	// get article
 	articleID, err := strconv.Atoi(req.GetSlug())
@@ -94,8 +151,17 @@ func (h *Handler) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*p
 	// get current user if exists
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
+		if !article.VisibleTo(nil) {
+			msg := fmt.Sprintf("requested article (slug=%d) is not visible to anonymous users", articleID)
+			h.logger.Error().Msg(msg)
+			return nil, status.Error(codes.InvalidArgument, "invalid article id")
+		}
+
 		pa := article.ProtoArticle(false)
 		pa.Author = article.Author.ProtoProfile(false)
+		if err := h.setArticleReactions([]*pb.Article{pa}, []uint{article.ID}, nil); err != nil {
+			return nil, err
+		}
 		return &pb.ArticleResponse{Article: pa}, nil
 	}
 
@@ -106,6 +172,13 @@ func (h *Handler) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*p
 		return nil, status.Error(codes.NotFound, msg)
 	}
 
+	if !article.VisibleTo(currentUser) {
+		msg := fmt.Sprintf("user(id=%d) attempted to read unpublished article(id=%d)",
+			currentUser.ID, article.ID)
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
 	// get whether the article is current user's favorite
 	favorited, err := h.as.IsFavorited(article, currentUser)
 	if err != nil {
@@ -115,6 +188,15 @@ func (h *Handler) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*p
 	}
 	pa := article.ProtoArticle(favorited)
 
+	// get whether the article is in current user's bookmarks
+	bookmarked, err := h.as.IsBookmarked(article, currentUser)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa.Bookmarked = bookmarked
+
 	// get whether current user follows article author
 	following, err := h.us.IsFollowing(currentUser, &article.Author)
 	if err != nil {
@@ -124,12 +206,16 @@ func (h *Handler) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*p
 	}
 	pa.Author = article.Author.ProtoProfile(following)
 
+	if err := h.setArticleReactions([]*pb.Article{pa}, []uint{article.ID}, currentUser); err != nil {
+		return nil, err
+	}
+
 	return &pb.ArticleResponse{Article: pa}, nil
 }
 
 // GetArticles gets recent articles globally
 func (h *Handler) GetArticles(ctx context.Context, req *pb.GetArticlesRequest) (*pb.ArticlesResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("get articles")
+	h = h.withContext(ctx)
 
 	limitQuery := req.GetLimit()
 	if limitQuery == 0 {
@@ -147,12 +233,6 @@ func (h *Handler) GetArticles(ctx context.Context, req *pb.GetArticlesRequest) (
 		}
 	}
 
-	as, err := h.as.GetArticles(req.GetTag(), req.GetAuthor(), favoritedBy, limitQuery, req.GetOffset())
-	if err != nil {
-		h.logger.Error().Err(err).Msg("failed to search articles in the database")
-		return nil, status.Error(codes.Aborted, "internal server error")
-	}
-
 	var currentUser *model.User
 	userID, err := auth.GetUserID(ctx)
 	if err == nil {
@@ -163,6 +243,32 @@ func (h *Handler) GetArticles(ctx context.Context, req *pb.GetArticlesRequest) (
 		}
 	}
 
+	// articles of muted or blocked users are hidden
+	hiddenUserIDs, err := h.us.GetHiddenUserIDs(currentUser)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to get hidden user ids")
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	as, err := h.as.GetArticles(req.GetTag(), req.GetAuthor(), favoritedBy, hiddenUserIDs, limitQuery, req.GetOffset())
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to search articles in the database")
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	ids := make([]uint, 0, len(as))
+	for _, a := range as {
+		ids = append(ids, a.ID)
+	}
+
+	// get bookmarks of current user at once rather than for each article
+	bookmarked, err := h.as.GetBookmarkedIDs(currentUser, ids)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
 	pas := make([]*pb.Article, 0, len(as))
 	for _, a := range as {
 		// get whether the article is current user's favorite
@@ -173,6 +279,7 @@ func (h *Handler) GetArticles(ctx context.Context, req *pb.GetArticlesRequest) (
 			return nil, status.Error(codes.Aborted, "internal server error")
 		}
 		pa := a.ProtoArticle(favorited)
+		pa.Bookmarked = bookmarked[a.ID]
 
 		// get whether current user follows article author
 		following, err := h.us.IsFollowing(currentUser, &a.Author)
@@ -186,12 +293,17 @@ func (h *Handler) GetArticles(ctx context.Context, req *pb.GetArticlesRequest) (
 		pas = append(pas, pa)
 	}
 
+	// get reactions of all articles at once
+	if err := h.setArticleReactions(pas, ids, currentUser); err != nil {
+		return nil, err
+	}
+
 	return &pb.ArticlesResponse{Articles: pas, ArticlesCount: int32(len(pas))}, nil
 }
 
 // GetFeedArticles gets recent articles from users current user follow
 func (h *Handler) GetFeedArticles(ctx context.Context, req *pb.GetFeedArticlesRequest) (*pb.ArticlesResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("get feed article")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -212,6 +324,14 @@ func (h *Handler) GetFeedArticles(ctx context.Context, req *pb.GetFeedArticlesRe
 		return nil, status.Error(codes.NotFound, "internal server error")
 	}
 
+	// articles of muted users are hidden
+	hiddenUserIDs, err := h.us.GetHiddenUserIDs(currentUser)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to get hidden user ids")
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	userIDs = excludeIDs(userIDs, hiddenUserIDs)
+
 	limitQuery := req.GetLimit()
 	if limitQuery == 0 {
 		limitQuery = 20
@@ -224,6 +344,19 @@ func (h *Handler) GetFeedArticles(ctx context.Context, req *pb.GetFeedArticlesRe
 		return nil, status.Error(codes.NotFound, "internal server error")
 	}
 
+	ids := make([]uint, 0, len(as))
+	for _, a := range as {
+		ids = append(ids, a.ID)
+	}
+
+	// get bookmarks of current user at once rather than for each article
+	bookmarked, err := h.as.GetBookmarkedIDs(currentUser, ids)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
 	pas := make([]*pb.Article, 0, len(as))
 	for _, a := range as {
 		// get whether the article is current user's favorite
@@ -234,6 +367,7 @@ func (h *Handler) GetFeedArticles(ctx context.Context, req *pb.GetFeedArticlesRe
 			return nil, status.Error(codes.Aborted, "internal server error")
 		}
 		pa := a.ProtoArticle(favorited)
+		pa.Bookmarked = bookmarked[a.ID]
 
 		// get whether current user follows article author
 		following, err := h.us.IsFollowing(currentUser, &a.Author)
@@ -247,12 +381,17 @@ func (h *Handler) GetFeedArticles(ctx context.Context, req *pb.GetFeedArticlesRe
 		pas = append(pas, pa)
 	}
 
+	// get reactions of all articles at once
+	if err := h.setArticleReactions(pas, ids, currentUser); err != nil {
+		return nil, err
+	}
+
 	return &pb.ArticlesResponse{Articles: pas, ArticlesCount: int32(len(pas))}, nil
 }
 
 // UpdateArticle updates an article
 func (h *Handler) UpdateArticle(ctx context.Context, req *pb.UpdateArticleRequest) (*pb.ArticleResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("update article")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -291,6 +430,11 @@ func (h *Handler) UpdateArticle(ctx context.Context, req *pb.UpdateArticleReques
 		return nil, status.Errorf(codes.Unauthenticated, "forbidden")
 	}
 
+	if err := h.checkArticleIfMatch(ctx, slug); err != nil {
+		return nil, err
+	}
+
+	prevText := article.Text()
 	article.Overwrite(
 		req.GetArticle().GetTitle(),
 		req.GetArticle().GetDescription(),
@@ -304,15 +448,55 @@ func (h *Handler) UpdateArticle(ctx context.Context, req *pb.UpdateArticleReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = article.RenderBody()
+	if err != nil {
+		msg := "failed to render article body"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	// check only changed contents not to take the article itself as a duplicate
+	var decision filter.Decision
+	if article.Text() != prevText {
+		decision, err = h.filterContent(ctx, filter.KindArticle, currentUser, article.Text())
+		if err != nil {
+			return nil, err
+		}
+	}
+	held := decision.Action == filter.Hold
+	if held {
+		now := time.Now()
+		article.HiddenAt = &now
+	}
+
 	if err := h.as.Update(article); err != nil {
 		h.logger.Error().Err(err).Msg("failed to update article")
 		return nil, status.Error(codes.InvalidArgument, "internal server error")
 	}
 
+	if held {
+		if err := h.holdContent(model.ReportTargetArticle, article.ID, decision); err != nil {
+			h.logger.Error().Err(err).Str("target_type", model.ReportTargetArticle).Uint("target_id", article.ID).
+				Msg("failed to hold content")
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+	} else {
+		h.triggerArticleWebhooks(model.EventArticleUpdated, article, nil)
+	}
+
 	// get whether the article is current user's favorite
 	favorited := true
 	pa := article.ProtoArticle(favorited)
 
+	// get whether the article is in current user's bookmarks
+	bookmarked, err := h.as.IsBookmarked(article, currentUser)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa.Bookmarked = bookmarked
+
 	// get whether current user follows article author
 	following, err := h.us.IsFollowing(currentUser, &article.Author)
 	if err != nil {
@@ -327,7 +511,7 @@ func (h *Handler) UpdateArticle(ctx context.Context, req *pb.UpdateArticleReques
 
 // DeleteArticle deletes an article
 func (h *Handler) DeleteArticle(ctx context.Context, req *pb.DeleteArticleRequest) (*pb.Empty, error) {
-	h.logger.Info().Interface("req", req).Msg("delete article")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -366,18 +550,24 @@ func (h *Handler) DeleteArticle(ctx context.Context, req *pb.DeleteArticleReques
 		return nil, status.Errorf(codes.Unauthenticated, "forbidden")
 	}
 
+	if err := h.checkArticleIfMatch(ctx, slug); err != nil {
+		return nil, err
+	}
+
 	if err := h.as.Delete(article); err != nil {
 		msg := "failed to delete article"
 		h.logger.Error().Err(err).Msg(msg)
 		return nil, status.Errorf(codes.Unauthenticated, msg)
 	}
 
+	h.triggerArticleWebhooks(model.EventArticleDeleted, article, nil)
+
 	return &pb.Empty{}, nil
 }
 
 // FavoriteArticle add an article to user favorites
 func (h *Handler) FavoriteArticle(ctx context.Context, req *pb.FavoriteArticleRequest) (*pb.ArticleResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("favorite article")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -403,22 +593,40 @@ func (h *Handler) FavoriteArticle(ctx context.Context, req *pb.FavoriteArticleRe
 	}
 
 	article, err := h.as.GetByID(uint(articleID))
-	if err != nil {
+	if err != nil || !article.VisibleTo(currentUser) {
 		msg := fmt.Sprintf("requested article (slug=%d) not found", articleID)
 		h.logger.Error().Err(err).Msg(msg)
 		return nil, status.Error(codes.InvalidArgument, "invalid article id")
 	}
 
-	err = h.as.AddFavorite(article, currentUser)
+	added, err := h.as.AddFavorite(article, currentUser)
 	if err != nil {
 		msg := "failed to add favorite"
 		h.logger.Error().Err(err).Msg(msg)
 		return nil, status.Error(codes.InvalidArgument, msg)
 	}
 
+	// favoriting again announces nothing
+	if added {
+		h.notify(model.NewFavoriteNotification(currentUser, article))
+		h.triggerArticleWebhooks(model.EventArticleFavorited, article, map[string]proto.Message{
+			"user": currentUser.ProtoProfile(false),
+		})
+	}
+
 	// get whether current user follows article author
 	favorited := true
 	pa := article.ProtoArticle(favorited)
+
+	// get whether the article is in current user's bookmarks
+	bookmarked, err := h.as.IsBookmarked(article, currentUser)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa.Bookmarked = bookmarked
+
 	following, err := h.us.IsFollowing(currentUser, &article.Author)
 	if err != nil {
 		msg := "failed to get following status"
@@ -432,7 +640,7 @@ func (h *Handler) FavoriteArticle(ctx context.Context, req *pb.FavoriteArticleRe
 
 // UnfavoriteArticle removes an article from user favorites
 func (h *Handler) UnfavoriteArticle(ctx context.Context, req *pb.UnfavoriteArticleRequest) (*pb.ArticleResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("unfavorite article")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -458,13 +666,13 @@ func (h *Handler) UnfavoriteArticle(ctx context.Context, req *pb.UnfavoriteArtic
 	}
 
 	article, err := h.as.GetByID(uint(articleID))
-	if err != nil {
+	if err != nil || !article.VisibleTo(currentUser) {
 		msg := fmt.Sprintf("requested article (slug=%d) not found", articleID)
 		h.logger.Error().Err(err).Msg(msg)
 		return nil, status.Error(codes.InvalidArgument, "invalid article id")
 	}
 
-	err = h.as.DeleteFavorite(article, currentUser)
+	_, err = h.as.DeleteFavorite(article, currentUser)
 	if err != nil {
 		msg := "failed to remove favorite"
 		h.logger.Error().Err(err).Msg(msg)
@@ -474,6 +682,16 @@ func (h *Handler) UnfavoriteArticle(ctx context.Context, req *pb.UnfavoriteArtic
 	// get whether current user follows article author
 	favorited := false
 	pa := article.ProtoArticle(favorited)
+
+	// get whether the article is in current user's bookmarks
+	bookmarked, err := h.as.IsBookmarked(article, currentUser)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa.Bookmarked = bookmarked
+
 	following, err := h.us.IsFollowing(currentUser, &article.Author)
 	if err != nil {
 		msg := "failed to get following status"
@@ -484,3 +702,55 @@ func (h *Handler) UnfavoriteArticle(ctx context.Context, req *pb.UnfavoriteArtic
 
 	return &pb.ArticleResponse{Article: pa}, nil
 }
+
+// renderBatchSize is the number of articles rendered at once by RenderArticleBodies
+const renderBatchSize = 100
+
+// RenderArticleBodies renders bodies of articles written before bodies were
+// rendered on write. Articles rendered already are left as they are.
+func (h *Handler) RenderArticleBodies() error {
+	var after uint
+	n := 0
+	for {
+		as, err := h.as.RenderMissingBodies(after, renderBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to render article bodies: %w", err)
+		}
+		if len(as) == 0 {
+			break
+		}
+		n += len(as)
+		after = as[len(as)-1].ID
+	}
+
+	if n > 0 {
+		h.logger.Info().Int("count", n).Msg("rendered article bodies")
+	}
+
+	return nil
+}
+
+// excludeIDs returns ids which are not in excluded
+func excludeIDs(ids, excluded []uint) []uint {
+	set := make(map[uint]bool, len(excluded))
+	for _, id := range excluded {
+		set[id] = true
+	}
+
+	kept := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !set[id] {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
+
+// parseTime parses a time string formatted as ISO8601 or RFC3339
+func parseTime(s string) (time.Time, error) {
+	t, err := time.Parse(model.ISO8601, s)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
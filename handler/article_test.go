@@ -3,6 +3,8 @@ package handler
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -154,7 +156,7 @@ func TestGetArticle(t *testing.T) {
 		}
 	}
 
-	if err := h.as.AddFavorite(&awesomeArticle, &barUser); err != nil {
+	if _, err := h.as.AddFavorite(&awesomeArticle, &barUser); err != nil {
 		t.Fatalf("failed to create initial favorite articles: %v", err)
 	}
 
@@ -263,6 +265,21 @@ func TestGetArticles(t *testing.T) {
 		}
 	}
 
+	// a draft favorited before it was unpublished is left out of pages
+	draft := model.Article{
+		Title:       "draft",
+		Description: "draft",
+		Body:        "draft",
+		Author:      barUser,
+		Status:      model.ArticleStatusDraft,
+	}
+	if err := h.as.Create(&draft); err != nil {
+		t.Fatalf("failed to create initial article record: %v", err)
+	}
+	if _, err := h.as.AddFavorite(&draft, &fooUser); err != nil {
+		t.Fatalf("failed to create initial favorite articles: %v", err)
+	}
+
 	tag := model.Tag{Name: "hoge"}
 
 	articles := make([]*model.Article, 10)
@@ -288,7 +305,7 @@ func TestGetArticles(t *testing.T) {
 			t.Fatalf("failed to create initial article record: %v", err)
 		}
 		if i < 5 {
-			if err := h.as.AddFavorite(a, &fooUser); err != nil {
+			if _, err := h.as.AddFavorite(a, &fooUser); err != nil {
 				t.Fatalf("failed to create initial favorite articles: %v", err)
 			}
 		}
@@ -372,6 +389,30 @@ func TestGetArticles(t *testing.T) {
 			articles[0:5],
 			false,
 		},
+		{
+			"get articles with favorited query, limit and offset",
+			&pb.GetArticlesRequest{
+				Tag:       "",
+				Author:    "",
+				Favorited: "foo",
+				Limit:     2,
+				Offset:    2,
+			},
+			articles[2:4],
+			false,
+		},
+		{
+			"get first page of articles with favorited query",
+			&pb.GetArticlesRequest{
+				Tag:       "",
+				Author:    "",
+				Favorited: "foo",
+				Limit:     2,
+				Offset:    0,
+			},
+			articles[0:2],
+			false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -776,6 +817,188 @@ func TestDeleteArticle(t *testing.T) {
 	}
 }
 
+func TestDeleteArticleCascade(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"cascade"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	if _, err := h.FavoriteArticle(ctxs["bar"], &pb.FavoriteArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+
+	if _, err := h.BookmarkArticle(ctxs["bar"], &pb.BookmarkArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to bookmark article: %v", err)
+	}
+
+	_, err = h.AddArticleReaction(ctxs["bar"], &pb.AddArticleReactionRequest{Slug: slug, Kind: "like"})
+	if err != nil {
+		t.Fatalf("failed to react to article: %v", err)
+	}
+
+	var ids []string
+	for _, body := range []string{"first", "second"} {
+		c, err := h.CreateComment(ctxs["bar"], &pb.CreateCommentRequest{
+			Slug:    slug,
+			Comment: &pb.CreateCommentRequest_Comment{Body: body},
+		})
+		if err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+		ids = append(ids, c.GetComment().GetId())
+	}
+
+	_, err = h.AddCommentReaction(ctxs["bar"], &pb.AddCommentReactionRequest{Slug: slug, Id: ids[0], Kind: "like"})
+	if err != nil {
+		t.Fatalf("failed to react to comment: %v", err)
+	}
+
+	// deleted before the article, so it must stay deleted after restoring the article
+	if _, err := h.DeleteComment(ctxs["bar"], &pb.DeleteCommentRequest{Slug: slug, Id: ids[1]}); err != nil {
+		t.Fatalf("failed to delete comment: %v", err)
+	}
+
+	articleID, err := strconv.Atoi(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	article, err := h.as.GetByID(uint(articleID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	relations := []struct {
+		title   string
+		visible func() bool
+	}{
+		{
+			"comments",
+			func() bool {
+				cs, err := h.as.GetComments(article)
+				return err == nil && len(cs) == 1 && fmt.Sprintf("%d", cs[0].ID) == ids[0]
+			},
+		},
+		{
+			"comment reactions",
+			func() bool {
+				resp, err := h.ListCommentReactors(ctxs["foo"],
+					&pb.ListCommentReactorsRequest{Slug: slug, Id: ids[0], Kind: "like"})
+				return err == nil && len(resp.GetProfiles()) == 1
+			},
+		},
+		{
+			"favorites",
+			func() bool {
+				resp, err := h.GetArticles(ctxs["foo"], &pb.GetArticlesRequest{Favorited: "bar"})
+				return err == nil && len(resp.GetArticles()) == 1
+			},
+		},
+		{
+			"favorites count",
+			func() bool {
+				resp, err := h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "foo"})
+				return err == nil && resp.GetProfile().GetFavoritesCount() == 1
+			},
+		},
+		{
+			"tags",
+			func() bool {
+				resp, err := h.GetTags(context.Background(), &pb.Empty{})
+				return err == nil && len(resp.GetTags()) == 1
+			},
+		},
+		{
+			"bookmarks",
+			func() bool {
+				resp, err := h.GetBookmarkedArticles(ctxs["bar"], &pb.GetBookmarkedArticlesRequest{})
+				return err == nil && len(resp.GetArticles()) == 1
+			},
+		},
+		{
+			"article reactions",
+			func() bool {
+				resp, err := h.ListArticleReactors(ctxs["foo"],
+					&pb.ListArticleReactorsRequest{Slug: slug, Kind: "like"})
+				return err == nil && len(resp.GetProfiles()) == 1
+			},
+		},
+		{
+			"revisions",
+			func() bool {
+				resp, err := h.ListArticleRevisions(ctxs["foo"], &pb.ListArticleRevisionsRequest{Slug: slug})
+				return err == nil && len(resp.GetRevisions()) == 1
+			},
+		},
+	}
+
+	for _, r := range relations {
+		assert.True(t, r.visible(), "before deletion: %s", r.title)
+	}
+
+	if _, err := h.DeleteArticle(ctxs["foo"], &pb.DeleteArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to delete article: %v", err)
+	}
+
+	for _, r := range relations {
+		assert.False(t, r.visible(), "after deletion: %s", r.title)
+	}
+
+	// deleted comments of deleted articles are not in the trash
+	trash, err := h.GetTrashedComments(ctxs["bar"], &pb.GetTrashedCommentsRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, trash.GetComments())
+	}
+
+	if _, err := h.RestoreArticle(ctxs["foo"], &pb.RestoreArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to restore article: %v", err)
+	}
+
+	for _, r := range relations {
+		assert.True(t, r.visible(), "after restoration: %s", r.title)
+	}
+
+	trash, err = h.GetTrashedComments(ctxs["bar"], &pb.GetTrashedCommentsRequest{})
+	if assert.NoError(t, err) && assert.Len(t, trash.GetComments(), 1) {
+		assert.Equal(t, ids[1], trash.GetComments()[0].GetId())
+	}
+}
+
 func TestFavoriteArticle(t *testing.T) {
 	h, cleaner := setUp(t)
 	defer cleaner(t)
@@ -905,7 +1128,7 @@ func TestUnfavoriteArticle(t *testing.T) {
 		if err := h.as.Create(a); err != nil {
 			t.Fatalf("failed to create initial article record: %v", err)
 		}
-		if err := h.as.AddFavorite(&af, &fooUser); err != nil {
+		if _, err := h.as.AddFavorite(&af, &fooUser); err != nil {
 			t.Fatalf("failed to create initial favorite articles: %v", err)
 		}
 	}
@@ -961,3 +1184,74 @@ func TestUnfavoriteArticle(t *testing.T) {
 		assert.Equal(t, tt.favoritesCount, got.GetFavoritesCount())
 	}
 }
+
+func TestArticleBodyHTML(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	if err := h.us.Create(&fooUser); err != nil {
+		t.Fatalf("failed to create initial user record: %v", err)
+	}
+
+	token, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := ctxWithToken(context.Background(), token)
+
+	body := "# Hello World\n\n" +
+		"some *text* <script>alert(1)</script>\n\n" +
+		"[link](javascript:alert(1))\n\n" +
+		"```go\nfmt.Println(1)\n```\n"
+
+	resp, err := h.CreateArticle(ctx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:   "markdown",
+			Body:    body,
+			TagList: []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+
+	got := resp.GetArticle()
+	assert.Equal(t, body, got.GetBody())
+	assert.Contains(t, got.GetBodyHtml(), `<h1 id="hello-world">Hello World</h1>`)
+	assert.Contains(t, got.GetBodyHtml(), `<em>text</em>`)
+	assert.Contains(t, got.GetBodyHtml(), `<code class="language-go">`)
+	assert.NotContains(t, got.GetBodyHtml(), "<script")
+	assert.NotContains(t, got.GetBodyHtml(), "javascript:")
+	assert.Equal(t, int32(1), got.GetReadingTime())
+	assert.True(t, got.GetWordCount() > 0)
+
+	// stored html is returned by listing
+	list, err := h.GetArticles(ctx, &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) && assert.Len(t, list.GetArticles(), 1) {
+		assert.Equal(t, got.GetBodyHtml(), list.GetArticles()[0].GetBodyHtml())
+	}
+
+	words := make([]string, 0, 120)
+	for i := 0; i < 120; i++ {
+		words = append(words, "a")
+	}
+
+	updated, err := h.UpdateArticle(ctx, &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{
+			Slug: got.GetSlug(),
+			Body: strings.Join(words, " "),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to update article: %v", err)
+	}
+	assert.NotContains(t, updated.GetArticle().GetBodyHtml(), "<h1")
+	assert.Equal(t, int32(120), updated.GetArticle().GetWordCount())
+	assert.Equal(t, int32(1), updated.GetArticle().GetReadingTime())
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockUser blocks a user
+func (h *Handler) BlockUser(ctx context.Context, req *pb.BlockRequest) (*pb.ProfileResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, requestUser, err := h.getOtherUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.us.Block(currentUser, requestUser)
+	if err != nil {
+		msg := fmt.Sprintf("failed to block user: (ID: %d) -> (ID: %d)",
+			currentUser.ID, requestUser.ID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "failed to block user")
+	}
+
+	// blocking removes follow relationships
+	return &pb.ProfileResponse{Profile: requestUser.ProtoProfile(false)}, nil
+}
+
+// UnblockUser unblocks a user
+func (h *Handler) UnblockUser(ctx context.Context, req *pb.UnblockRequest) (*pb.ProfileResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, requestUser, err := h.getOtherUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.us.Unblock(currentUser, requestUser)
+	if err != nil {
+		msg := fmt.Sprintf("failed to unblock user: (ID: %d) -> (ID: %d)",
+			currentUser.ID, requestUser.ID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "failed to unblock user")
+	}
+
+	return &pb.ProfileResponse{Profile: requestUser.ProtoProfile(false)}, nil
+}
+
+// ListBlockedUsers lists users current user blocks
+func (h *Handler) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ProfilesResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	us, err := h.us.GetBlockedUsers(currentUser, limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get blocked users"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pps := make([]*pb.Profile, 0, len(us))
+	for _, u := range us {
+		pps = append(pps, u.ProtoProfile(false))
+	}
+
+	return &pb.ProfilesResponse{Profiles: pps}, nil
+}
+
+// MuteUser mutes a user
+func (h *Handler) MuteUser(ctx context.Context, req *pb.MuteRequest) (*pb.ProfileResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, requestUser, err := h.getOtherUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.us.Mute(currentUser, requestUser)
+	if err != nil {
+		msg := fmt.Sprintf("failed to mute user: (ID: %d) -> (ID: %d)",
+			currentUser.ID, requestUser.ID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "failed to mute user")
+	}
+
+	return h.profileResponse(currentUser, requestUser)
+}
+
+// UnmuteUser unmutes a user
+func (h *Handler) UnmuteUser(ctx context.Context, req *pb.UnmuteRequest) (*pb.ProfileResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, requestUser, err := h.getOtherUser(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.us.Unmute(currentUser, requestUser)
+	if err != nil {
+		msg := fmt.Sprintf("failed to unmute user: (ID: %d) -> (ID: %d)",
+			currentUser.ID, requestUser.ID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "failed to unmute user")
+	}
+
+	return h.profileResponse(currentUser, requestUser)
+}
+
+// ListMutedUsers lists users current user mutes
+func (h *Handler) ListMutedUsers(ctx context.Context, req *pb.ListMutedUsersRequest) (*pb.ProfilesResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	us, err := h.us.GetMutedUsers(currentUser, limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get muted users"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pps := make([]*pb.Profile, 0, len(us))
+	for _, u := range us {
+		following, err := h.us.IsFollowing(currentUser, &u)
+		if err != nil {
+			msg := "failed to get following status"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+		pps = append(pps, u.ProtoProfile(following))
+	}
+
+	return &pb.ProfilesResponse{Profiles: pps}, nil
+}
+
+// getOtherUser returns current user and the user of the username who is not current user
+func (h *Handler) getOtherUser(ctx context.Context, username string) (*model.User, *model.User, error) {
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if currentUser.Username == username {
+		msg := "cannot block or mute yourself"
+		h.logger.Error().Msg(msg)
+		return nil, nil, status.Error(codes.InvalidArgument, msg)
+	}
+
+	requestUser, err := h.us.GetByUsername(username)
+	if err != nil {
+		msg := "user was not found"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, nil, status.Error(codes.NotFound, msg)
+	}
+
+	return currentUser, requestUser, nil
+}
+
+// profileResponse returns the profile of the user seen from current user
+func (h *Handler) profileResponse(currentUser, u *model.User) (*pb.ProfileResponse, error) {
+	following, err := h.us.IsFollowing(currentUser, u)
+	if err != nil {
+		msg := "failed to get following status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return &pb.ProfileResponse{Profile: u.ProtoProfile(following)}, nil
+}
+
+// isHidden returns whether contents of the author are hidden from the user,
+// because the user mutes the author or either of them blocks the other
+func (h *Handler) isHidden(u, author *model.User) (bool, error) {
+	muting, err := h.us.IsMuting(u, author)
+	if err != nil || muting {
+		return muting, err
+	}
+
+	return h.us.IsBlockedBetween(u, author)
+}
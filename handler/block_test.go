@@ -0,0 +1,380 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func profileNames(ps []*pb.Profile) []string {
+	names := make([]string, 0, len(ps))
+	for _, p := range ps {
+		names = append(names, p.GetUsername())
+	}
+	return names
+}
+
+func articleSlugs(as []*pb.Article) []string {
+	slugs := make([]string, 0, len(as))
+	for _, a := range as {
+		slugs = append(slugs, a.GetSlug())
+	}
+	return slugs
+}
+
+func commentIDs(cs []*pb.Comment) []string {
+	ids := make([]string, 0, len(cs))
+	for _, c := range cs {
+		ids = append(ids, c.GetId())
+	}
+	return ids
+}
+
+func TestBlockUser(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	bazUser := model.User{
+		Username: "baz",
+		Email:    "baz@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser, &bazUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	for _, f := range [][2]*model.User{{&fooUser, &barUser}, {&barUser, &fooUser}} {
+		if err := h.us.Follow(f[0], f[1]); err != nil {
+			t.Fatalf("failed to create initial user relationship: %v", err)
+		}
+	}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser, &bazUser} {
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	comment, err := h.CreateComment(ctxs["bar"], &pb.CreateCommentRequest{
+		Slug:    slug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	_, err = h.BlockUser(ctxs["foo"], &pb.BlockRequest{Username: "foo"})
+	assert.Error(t, err, "cannot block yourself")
+
+	_, err = h.BlockUser(context.Background(), &pb.BlockRequest{Username: "bar"})
+	assert.Error(t, err, "unauthenticated")
+
+	blocked, err := h.BlockUser(ctxs["foo"], &pb.BlockRequest{Username: "bar"})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.False(t, blocked.GetProfile().GetFollowing())
+
+	// follow relationships are removed in either direction
+	for _, f := range [][2]*model.User{{&fooUser, &barUser}, {&barUser, &fooUser}} {
+		following, err := h.us.IsFollowing(f[0], f[1])
+		if assert.NoError(t, err) {
+			assert.False(t, following, "%s -> %s", f[0].Username, f[1].Username)
+		}
+	}
+
+	tests := []struct {
+		title    string
+		call     func() error
+		hasError bool
+	}{
+		{
+			"show blocker's profile: hidden",
+			func() error {
+				_, err := h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "foo"})
+				return err
+			},
+			true,
+		},
+		{
+			"show blocked user's profile: success",
+			func() error {
+				_, err := h.ShowProfile(ctxs["foo"], &pb.ShowProfileRequest{Username: "bar"})
+				return err
+			},
+			false,
+		},
+		{
+			"follow blocker: forbidden",
+			func() error {
+				_, err := h.FollowUser(ctxs["bar"], &pb.FollowRequest{Username: "foo"})
+				return err
+			},
+			true,
+		},
+		{
+			"follow blocked user: forbidden",
+			func() error {
+				_, err := h.FollowUser(ctxs["foo"], &pb.FollowRequest{Username: "bar"})
+				return err
+			},
+			true,
+		},
+		{
+			"comment on blocker's article: forbidden",
+			func() error {
+				_, err := h.CreateComment(ctxs["bar"], &pb.CreateCommentRequest{
+					Slug:    slug,
+					Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+				})
+				return err
+			},
+			true,
+		},
+		{
+			"comment by other user: success",
+			func() error {
+				_, err := h.CreateComment(ctxs["baz"], &pb.CreateCommentRequest{
+					Slug:    slug,
+					Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+				})
+				return err
+			},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		err := tt.call()
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+		} else {
+			assert.NoError(t, err, tt.title)
+		}
+	}
+
+	// contents are hidden from each other
+	for _, tt := range []struct {
+		username string
+		articles []string
+	}{
+		{"foo", []string{slug}},
+		{"bar", []string{}},
+		{"baz", []string{slug}},
+	} {
+		articles, err := h.GetArticles(ctxs[tt.username], &pb.GetArticlesRequest{})
+		if assert.NoError(t, err, tt.username) {
+			assert.Equal(t, tt.articles, articleSlugs(articles.GetArticles()), tt.username)
+		}
+	}
+
+	comments, err := h.GetComments(ctxs["foo"], &pb.GetCommentsRequest{Slug: slug})
+	if assert.NoError(t, err) {
+		assert.NotContains(t, commentIDs(comments.GetComments()), comment.GetComment().GetId())
+	}
+
+	comments, err = h.GetComments(ctxs["baz"], &pb.GetCommentsRequest{Slug: slug})
+	if assert.NoError(t, err) {
+		assert.Contains(t, commentIDs(comments.GetComments()), comment.GetComment().GetId())
+	}
+
+	// blocks are listed only for the blocker
+	list, err := h.ListBlockedUsers(ctxs["foo"], &pb.ListBlockedUsersRequest{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"bar"}, profileNames(list.GetProfiles()))
+	}
+
+	list, err = h.ListBlockedUsers(ctxs["bar"], &pb.ListBlockedUsersRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, list.GetProfiles())
+	}
+
+	// unblock
+	if _, err := h.UnblockUser(ctxs["foo"], &pb.UnblockRequest{Username: "bar"}); err != nil {
+		t.Fatalf("failed to unblock user: %v", err)
+	}
+
+	list, err = h.ListBlockedUsers(ctxs["foo"], &pb.ListBlockedUsersRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, list.GetProfiles())
+	}
+
+	_, err = h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "foo"})
+	assert.NoError(t, err)
+
+	_, err = h.FollowUser(ctxs["bar"], &pb.FollowRequest{Username: "foo"})
+	assert.NoError(t, err)
+}
+
+func TestMuteUser(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	if err := h.us.Follow(&barUser, &fooUser); err != nil {
+		t.Fatalf("failed to create initial user relationship: %v", err)
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	var slugs []string
+	for _, ctx := range []context.Context{fooCtx, barCtx} {
+		resp, err := h.CreateArticle(ctx, &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       "title",
+				Description: "description",
+				Body:        "body",
+				TagList:     []string{"foo"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs = append(slugs, resp.GetArticle().GetSlug())
+	}
+	fooSlug, barSlug := slugs[0], slugs[1]
+
+	comment, err := h.CreateComment(fooCtx, &pb.CreateCommentRequest{
+		Slug:    barSlug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	_, err = h.MuteUser(barCtx, &pb.MuteRequest{Username: "bar"})
+	assert.Error(t, err, "cannot mute yourself")
+
+	_, err = h.MuteUser(barCtx, &pb.MuteRequest{Username: "nobody"})
+	assert.Error(t, err, "user not found")
+
+	muted, err := h.MuteUser(barCtx, &pb.MuteRequest{Username: "foo"})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	// muting keeps following
+	assert.True(t, muted.GetProfile().GetFollowing())
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		articles []string
+		feed     []string
+		comments []string
+	}{
+		{
+			"muted user's contents are hidden",
+			barCtx,
+			[]string{barSlug},
+			[]string{},
+			[]string{},
+		},
+		{
+			"muted user can see everything",
+			fooCtx,
+			[]string{fooSlug, barSlug},
+			[]string{},
+			[]string{comment.GetComment().GetId()},
+		},
+	}
+
+	for _, tt := range tests {
+		articles, err := h.GetArticles(tt.ctx, &pb.GetArticlesRequest{})
+		if assert.NoError(t, err, tt.title) {
+			assert.Equal(t, tt.articles, articleSlugs(articles.GetArticles()), tt.title)
+		}
+
+		feed, err := h.GetFeedArticles(tt.ctx, &pb.GetFeedArticlesRequest{})
+		if assert.NoError(t, err, tt.title) {
+			assert.Equal(t, tt.feed, articleSlugs(feed.GetArticles()), tt.title)
+		}
+
+		comments, err := h.GetComments(tt.ctx, &pb.GetCommentsRequest{Slug: barSlug})
+		if assert.NoError(t, err, tt.title) {
+			assert.Equal(t, tt.comments, commentIDs(comments.GetComments()), tt.title)
+		}
+	}
+
+	list, err := h.ListMutedUsers(barCtx, &pb.ListMutedUsersRequest{})
+	if assert.NoError(t, err) && assert.Len(t, list.GetProfiles(), 1) {
+		assert.Equal(t, "foo", list.GetProfiles()[0].GetUsername())
+		assert.True(t, list.GetProfiles()[0].GetFollowing())
+	}
+
+	// unmute
+	if _, err := h.UnmuteUser(barCtx, &pb.UnmuteRequest{Username: "foo"}); err != nil {
+		t.Fatalf("failed to unmute user: %v", err)
+	}
+
+	feed, err := h.GetFeedArticles(barCtx, &pb.GetFeedArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{fooSlug}, articleSlugs(feed.GetArticles()))
+	}
+
+	list, err = h.ListMutedUsers(barCtx, &pb.ListMutedUsersRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, list.GetProfiles())
+	}
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBookmarkedArticles gets articles current user bookmarked
+func (h *Handler) GetBookmarkedArticles(ctx context.Context, req *pb.GetBookmarkedArticlesRequest) (*pb.ArticlesResponse, error) {
+	h = h.withContext(ctx)
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("unauthenticated")
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	currentUser, err := h.us.GetByID(userID)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("current user not found")
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	as, err := h.as.GetBookmarkedArticles(currentUser, limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get bookmarked articles"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pas := make([]*pb.Article, 0, len(as))
+	for _, a := range as {
+		// get whether the article is current user's favorite
+		favorited, err := h.as.IsFavorited(&a, currentUser)
+		if err != nil {
+			msg := "failed to get favorited status"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+		pa := a.ProtoArticle(favorited)
+		pa.Bookmarked = true
+
+		// get whether current user follows article author
+		following, err := h.us.IsFollowing(currentUser, &a.Author)
+		if err != nil {
+			msg := "failed to get following status"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.NotFound, "internal server error")
+		}
+		pa.Author = a.Author.ProtoProfile(following)
+
+		pas = append(pas, pa)
+	}
+
+	// get reactions of all articles at once
+	ids := make([]uint, 0, len(as))
+	for _, a := range as {
+		ids = append(ids, a.ID)
+	}
+	if err := h.setArticleReactions(pas, ids, currentUser); err != nil {
+		return nil, err
+	}
+
+	return &pb.ArticlesResponse{Articles: pas, ArticlesCount: int32(len(pas))}, nil
+}
+
+// BookmarkArticle adds an article to current user's bookmarks
+func (h *Handler) BookmarkArticle(ctx context.Context, req *pb.BookmarkArticleRequest) (*pb.ArticleResponse, error) {
+	h = h.withContext(ctx)
+	return h.setBookmark(ctx, req.GetSlug(), true)
+}
+
+// UnbookmarkArticle removes an article from current user's bookmarks
+func (h *Handler) UnbookmarkArticle(ctx context.Context, req *pb.UnbookmarkArticleRequest) (*pb.ArticleResponse, error) {
+	h = h.withContext(ctx)
+	return h.setBookmark(ctx, req.GetSlug(), false)
+}
+
+// setBookmark adds or removes the article from current user's bookmarks
+func (h *Handler) setBookmark(ctx context.Context, slug string, bookmarked bool) (*pb.ArticleResponse, error) {
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		msg := "unauthenticated"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Errorf(codes.Unauthenticated, msg)
+	}
+
+	currentUser, err := h.us.GetByID(userID)
+	if err != nil {
+		msg := "not user found"
+		err = fmt.Errorf("token is valid but the user not found: %w", err)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, msg)
+	}
+
+	articleID, err := strconv.Atoi(slug)
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert slug (%s) into integer", slug)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	// only published articles can be bookmarked
+	article, err := h.as.GetByID(uint(articleID))
+	if err != nil || !article.IsPublished() {
+		msg := fmt.Sprintf("requested article (slug=%d) not found", articleID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	if bookmarked {
+		err = h.as.AddBookmark(article, currentUser)
+	} else {
+		err = h.as.DeleteBookmark(article, currentUser)
+	}
+	if err != nil {
+		msg := "failed to update bookmark"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	// get whether the article is current user's favorite
+	favorited, err := h.as.IsFavorited(article, currentUser)
+	if err != nil {
+		msg := "failed to get favorited status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa := article.ProtoArticle(favorited)
+	pa.Bookmarked = bookmarked
+
+	// get whether current user follows article author
+	following, err := h.us.IsFollowing(currentUser, &article.Author)
+	if err != nil {
+		msg := "failed to get following status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "internal server error")
+	}
+	pa.Author = article.Author.ProtoProfile(following)
+
+	return &pb.ArticleResponse{Article: pa}, nil
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarks(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	var slugs []string
+	for _, st := range []string{
+		model.ArticleStatusPublished,
+		model.ArticleStatusPublished,
+		model.ArticleStatusDraft,
+	} {
+		resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       "title",
+				Description: "description",
+				Body:        "body",
+				TagList:     []string{"foo"},
+				Status:      st,
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs = append(slugs, resp.GetArticle().GetSlug())
+	}
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		slug     string
+		hasError bool
+	}{
+		{"bookmark article: success", barCtx, slugs[0], false},
+		{"bookmark another article: success", barCtx, slugs[1], false},
+		{"bookmark article twice: success", barCtx, slugs[1], false},
+		{"bookmark draft: draft is not published", fooCtx, slugs[2], true},
+		{"bookmark article: unauthenticated", context.Background(), slugs[0], true},
+		{"bookmark article: article not found", barCtx, "0", true},
+	}
+
+	for _, tt := range tests {
+		resp, err := h.BookmarkArticle(tt.ctx, &pb.BookmarkArticleRequest{Slug: tt.slug})
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if !assert.NoError(t, err, tt.title) {
+			continue
+		}
+		assert.True(t, resp.GetArticle().GetBookmarked(), tt.title)
+		assert.Equal(t, int32(0), resp.GetArticle().GetFavoritesCount(), tt.title)
+	}
+
+	// recently bookmarked first
+	resp, err := h.GetBookmarkedArticles(barCtx, &pb.GetBookmarkedArticlesRequest{})
+	if assert.NoError(t, err) {
+		var got []string
+		for _, a := range resp.GetArticles() {
+			assert.True(t, a.GetBookmarked())
+			got = append(got, a.GetSlug())
+		}
+		assert.Equal(t, []string{slugs[1], slugs[0]}, got)
+	}
+
+	resp, err = h.GetBookmarkedArticles(barCtx, &pb.GetBookmarkedArticlesRequest{Limit: 1, Offset: 1})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetArticles(), 1) {
+		assert.Equal(t, slugs[0], resp.GetArticles()[0].GetSlug())
+	}
+
+	// nobody else can see the bookmarks
+	resp, err = h.GetBookmarkedArticles(fooCtx, &pb.GetBookmarkedArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, resp.GetArticles())
+	}
+
+	_, err = h.GetBookmarkedArticles(context.Background(), &pb.GetBookmarkedArticlesRequest{})
+	assert.Error(t, err)
+
+	for _, tt := range []struct {
+		ctx        context.Context
+		bookmarked bool
+	}{
+		{barCtx, true},
+		{fooCtx, false},
+		{context.Background(), false},
+	} {
+		got, err := h.GetArticle(tt.ctx, &pb.GetArticleRequest{Slug: slugs[0]})
+		if assert.NoError(t, err) {
+			assert.Equal(t, tt.bookmarked, got.GetArticle().GetBookmarked())
+		}
+	}
+
+	articles, err := h.GetArticles(barCtx, &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) {
+		for _, a := range articles.GetArticles() {
+			assert.True(t, a.GetBookmarked(), a.GetSlug())
+		}
+	}
+
+	// remove from bookmarks
+	unbookmarked, err := h.UnbookmarkArticle(barCtx, &pb.UnbookmarkArticleRequest{Slug: slugs[0]})
+	if assert.NoError(t, err) {
+		assert.False(t, unbookmarked.GetArticle().GetBookmarked())
+	}
+
+	resp, err = h.GetBookmarkedArticles(barCtx, &pb.GetBookmarkedArticlesRequest{})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetArticles(), 1) {
+		assert.Equal(t, slugs[1], resp.GetArticles()[0].GetSlug())
+	}
+
+	// pages mark only articles in the viewer's bookmarks
+	for _, tt := range []struct {
+		ctx        context.Context
+		bookmarked map[string]bool
+	}{
+		{barCtx, map[string]bool{slugs[0]: false, slugs[1]: true}},
+		{fooCtx, map[string]bool{slugs[0]: false, slugs[1]: false}},
+		{context.Background(), map[string]bool{slugs[0]: false, slugs[1]: false}},
+	} {
+		articles, err := h.GetArticles(tt.ctx, &pb.GetArticlesRequest{})
+		if assert.NoError(t, err) && assert.Len(t, articles.GetArticles(), 2) {
+			for _, a := range articles.GetArticles() {
+				assert.Equal(t, tt.bookmarked[a.GetSlug()], a.GetBookmarked(), a.GetSlug())
+			}
+		}
+	}
+
+	_, err = h.UnbookmarkArticle(context.Background(), &pb.UnbookmarkArticleRequest{Slug: slugs[1]})
+	assert.Error(t, err)
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/cache"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/raahii/golang-grpc-realworld-example/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedReads(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	// use a cache of its own to count lookups
+	backend := cache.NewMemory(cache.DefaultMemorySize)
+	c := cache.New(backend, time.Hour)
+	h.us = store.NewCachedUserStore(h.us.UserStore, c)
+	h.as = store.NewCachedArticleStore(h.as.ArticleStore, c)
+	h.rs = store.NewCachedReportStore(h.rs.ReportStore, c)
+
+	ctxs := map[string]context.Context{}
+	users := map[string]model.User{}
+	for _, name := range []string{"foo", "bar"} {
+		u := model.User{
+			Username: name,
+			Email:    name + "@example.com",
+			Password: "secret",
+		}
+		if err := u.HashPassword(); err != nil {
+			t.Fatal(err)
+		}
+		if err := h.us.Create(&u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[name] = ctxWithToken(context.Background(), token)
+		users[name] = u
+	}
+
+	resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"go"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	reads := []struct {
+		title string
+		read  func() error
+	}{
+		{"get article", func() error {
+			_, err := h.GetArticle(ctxs["bar"], &pb.GetArticleRequest{Slug: slug})
+			return err
+		}},
+		{"get articles", func() error {
+			_, err := h.GetArticles(context.Background(), &pb.GetArticlesRequest{})
+			return err
+		}},
+		{"get tags", func() error {
+			_, err := h.GetTags(context.Background(), &pb.Empty{})
+			return err
+		}},
+		{"show profile", func() error {
+			_, err := h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "foo"})
+			return err
+		}},
+	}
+
+	for _, tt := range reads {
+		if err := tt.read(); err != nil {
+			t.Fatalf("%s: %v", tt.title, err)
+		}
+
+		before := c.Stats()
+		if err := tt.read(); err != nil {
+			t.Fatalf("%s: %v", tt.title, err)
+		}
+		after := c.Stats()
+		assert.Greater(t, after.Hits, before.Hits, tt.title)
+		assert.Equal(t, before.Misses, after.Misses, tt.title)
+	}
+
+	// writes are visible in the following reads
+	if _, err := h.FavoriteArticle(ctxs["bar"], &pb.FavoriteArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+	if _, err := h.FollowUser(ctxs["bar"], &pb.FollowRequest{Username: "foo"}); err != nil {
+		t.Fatalf("failed to follow user: %v", err)
+	}
+	if _, err := h.UpdateArticle(ctxs["foo"], &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: slug, Title: "new title"},
+	}); err != nil {
+		t.Fatalf("failed to update article: %v", err)
+	}
+
+	article, err := h.GetArticle(ctxs["bar"], &pb.GetArticleRequest{Slug: slug})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "new title", article.GetArticle().GetTitle())
+		assert.Equal(t, int32(1), article.GetArticle().GetFavoritesCount())
+		assert.Equal(t, int32(1), article.GetArticle().GetAuthor().GetFollowersCount())
+	}
+
+	articles, err := h.GetArticles(context.Background(), &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) && assert.Len(t, articles.GetArticles(), 1) {
+		assert.Equal(t, "new title", articles.GetArticles()[0].GetTitle())
+		assert.Equal(t, int32(1), articles.GetArticles()[0].GetFavoritesCount())
+	}
+
+	profile, err := h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "foo"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(1), profile.GetProfile().GetFollowersCount())
+		assert.Equal(t, int32(1), profile.GetProfile().GetFavoritesCount())
+	}
+
+	// profiles are cached without credentials, which are kept by the writes above
+	cached, ok, err := backend.Get(context.Background(), fmt.Sprintf("user:%d", users["foo"].ID))
+	if assert.NoError(t, err) && assert.True(t, ok) {
+		assert.Contains(t, string(cached), "foo")
+		assert.NotContains(t, string(cached), users["foo"].Email)
+		assert.NotContains(t, string(cached), users["foo"].Password)
+	}
+
+	_, err = h.LoginUser(context.Background(), &pb.LoginUserRequest{
+		User: &pb.LoginUserRequest_User{Email: "foo@example.com", Password: "secret"},
+	})
+	assert.NoError(t, err)
+
+	// renamed users are not found by their old names
+	if _, err := h.UpdateUser(ctxs["foo"], &pb.UpdateUserRequest{
+		User: &pb.UpdateUserRequest_User{Username: "baz"},
+	}); err != nil {
+		t.Fatalf("failed to update user: %v", err)
+	}
+
+	_, err = h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "foo"})
+	assert.Error(t, err)
+
+	profile, err = h.ShowProfile(ctxs["bar"], &pb.ShowProfileRequest{Username: "baz"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "baz", profile.GetProfile().GetUsername())
+	}
+
+	// deleted articles are gone with their tags
+	if _, err := h.DeleteArticle(ctxs["foo"], &pb.DeleteArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to delete article: %v", err)
+	}
+
+	_, err = h.GetArticle(ctxs["bar"], &pb.GetArticleRequest{Slug: slug})
+	assert.Error(t, err)
+
+	tags, err := h.GetTags(context.Background(), &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, tags.GetTags())
+	}
+}
+
+func TestCacheFetch(t *testing.T) {
+	c := cache.New(cache.NewMemory(2), time.Hour)
+
+	// concurrent misses are loaded once
+	var loads int32
+	release := make(chan struct{})
+	load := func() (interface{}, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var v string
+			assert.NoError(t, c.Fetch(context.Background(), "a", &v, load))
+			assert.Equal(t, "value", v)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), loads)
+
+	// errors are not cached
+	fail := func() (interface{}, error) { return nil, errors.New("not found") }
+	var v string
+	assert.Error(t, c.Fetch(context.Background(), "b", &v, fail))
+	assert.NoError(t, c.Fetch(context.Background(), "b", &v, load))
+	assert.Equal(t, int32(2), loads)
+
+	// the least recently used entry is evicted
+	assert.NoError(t, c.Fetch(context.Background(), "c", &v, load))
+	assert.NoError(t, c.Fetch(context.Background(), "a", &v, load))
+	assert.Equal(t, int32(4), loads)
+
+	// deleted entries are loaded again
+	c.Delete(context.Background(), "a")
+	assert.NoError(t, c.Fetch(context.Background(), "a", &v, load))
+	assert.Equal(t, int32(5), loads)
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/filter"
+	"github.com/raahii/golang-grpc-realworld-example/metrics"
 	"github.com/raahii/golang-grpc-realworld-example/model"
 	pb "github.com/raahii/golang-grpc-realworld-example/proto"
 	"google.golang.org/grpc/codes"
@@ -14,7 +18,7 @@ import (
 
 // CreateComment create a comment for an article
 func (h *Handler) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CommentResponse, error) {
-	h.logger.Info().Msgf("Create comment | req: %+v", req)
+	h = h.withContext(ctx)
 
 	// get current user
 	userID, err := auth.GetUserID(ctx)
@@ -38,12 +42,26 @@ func (h *Handler) CreateComment(ctx context.Context, req *pb.CreateCommentReques
 	}
 
 	article, err := h.as.GetByID(uint(articleID))
-	if err != nil {
+	if err != nil || !article.VisibleTo(currentUser) {
 		msg := fmt.Sprintf("requested article (slug=%d) not found", articleID)
 		h.logger.Error().Err(err).Msg(msg)
 		return nil, status.Error(codes.InvalidArgument, "invalid article id")
 	}
 
+	// users cannot comment on articles of users blocking them or blocked by them
+	blocked, err := h.us.IsBlockedBetween(currentUser, &article.Author)
+	if err != nil {
+		msg := "failed to get blocked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	if blocked {
+		msg := fmt.Sprintf("user(id=%d) attempted to comment on article(id=%d) of blocking or blocked user",
+			currentUser.ID, article.ID)
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.PermissionDenied, "cannot comment on the article")
+	}
+
 	// new comment
 	comment := model.Comment{
 		Body:      req.GetComment().GetBody(),
@@ -58,6 +76,16 @@ func (h *Handler) CreateComment(ctx context.Context, req *pb.CreateCommentReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	decision, err := h.filterContent(ctx, filter.KindComment, currentUser, comment.Body)
+	if err != nil {
+		return nil, err
+	}
+	held := decision.Action == filter.Hold
+	if held {
+		now := time.Now()
+		comment.HiddenAt = &now
+	}
+
 	// create comment
 	err = h.as.CreateComment(&comment)
 	if err != nil {
@@ -66,16 +94,34 @@ func (h *Handler) CreateComment(ctx context.Context, req *pb.CreateCommentReques
 		return nil, status.Error(codes.Aborted, msg)
 	}
 
+	metrics.CommentsCreated.Inc()
+
 	// map model.Comment to pb.Comment
 	pc := comment.ProtoComment()
 	pc.Author = currentUser.ProtoProfile(false)
 
+	// held comments are announced only after staff review them
+	if held {
+		if err := h.holdContent(model.ReportTargetComment, comment.ID, decision); err != nil {
+			h.logger.Error().Err(err).Str("target_type", model.ReportTargetComment).Uint("target_id", comment.ID).
+				Msg("failed to hold content")
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+	} else {
+		h.notify(model.NewCommentNotification(currentUser, article, &comment))
+		h.publishComment(commentCreated, &comment)
+
+		h.triggerArticleWebhooks(model.EventCommentCreated, article, map[string]proto.Message{
+			"comment": pc,
+		})
+	}
+
 	return &pb.CommentResponse{Comment: pc}, nil
 }
 
 // GetComments gets comments of the article
 func (h *Handler) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (*pb.CommentsResponse, error) {
-	h.logger.Info().Msgf("Get comments | req: %+v", req)
+	h = h.withContext(ctx)
 
 	// get article
 	articleID, err := strconv.Atoi(req.GetSlug())
@@ -92,13 +138,6 @@ func (h *Handler) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (
 		return nil, status.Error(codes.InvalidArgument, "invalid article id")
 	}
 
-	comments, err := h.as.GetComments(article)
-	if err != nil {
-		msg := "failed to get comments"
-		h.logger.Error().Err(err).Msg(msg)
-		return nil, status.Error(codes.Aborted, msg)
-	}
-
 	var currentUser *model.User
 	userID, err := auth.GetUserID(ctx)
 	if err == nil {
@@ -109,8 +148,37 @@ func (h *Handler) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (
 		}
 	}
 
+	if !article.VisibleTo(currentUser) {
+		msg := fmt.Sprintf("requested article (slug=%d) is not published", articleID)
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	comments, err := h.as.GetComments(article)
+	if err != nil {
+		msg := "failed to get comments"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, msg)
+	}
+
+	// comments of muted or blocked users are hidden
+	hiddenUserIDs, err := h.us.GetHiddenUserIDs(currentUser)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to get hidden user ids")
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	hidden := make(map[uint]bool, len(hiddenUserIDs))
+	for _, id := range hiddenUserIDs {
+		hidden[id] = true
+	}
+
 	pcs := make([]*pb.Comment, 0, len(comments))
+	ids := make([]uint, 0, len(comments))
 	for _, c := range comments {
+		if hidden[c.UserID] {
+			continue
+		}
+
 		pc := c.ProtoComment()
 
 		// get whether current user follows article author
@@ -123,6 +191,12 @@ func (h *Handler) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (
 		pc.Author = c.Author.ProtoProfile(following)
 
 		pcs = append(pcs, pc)
+		ids = append(ids, c.ID)
+	}
+
+	// get reactions of all comments at once
+	if err := h.setCommentReactions(pcs, ids, currentUser); err != nil {
+		return nil, err
 	}
 
 	return &pb.CommentsResponse{Comments: pcs}, nil
@@ -130,7 +204,7 @@ func (h *Handler) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (
 
 // DeleteComment delete a commnet of the article
 func (h *Handler) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.Empty, error) {
-	h.logger.Info().Msgf("Delete comment | req: %+v", req)
+	h = h.withContext(ctx)
 
 	// get current user
 	userID, err := auth.GetUserID(ctx)
@@ -178,5 +252,7 @@ func (h *Handler) DeleteComment(ctx context.Context, req *pb.DeleteCommentReques
 		return nil, status.Error(codes.InvalidArgument, msg)
 	}
 
+	h.publishComment(commentDeleted, comment)
+
 	return &pb.Empty{}, nil
 }
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDraftArticles gets current user's articles which are not published yet
+func (h *Handler) GetDraftArticles(ctx context.Context, req *pb.GetDraftArticlesRequest) (*pb.ArticlesResponse, error) {
+	h = h.withContext(ctx)
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("unauthenticated")
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	currentUser, err := h.us.GetByID(userID)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("current user not found")
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	as, err := h.as.GetDraftArticles(currentUser, limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get draft articles"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pas := make([]*pb.Article, 0, len(as))
+	for _, a := range as {
+		// get whether the article is current user's favorite
+		favorited, err := h.as.IsFavorited(&a, currentUser)
+		if err != nil {
+			msg := "failed to get favorited status"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+		pa := a.ProtoArticle(favorited)
+		pa.Author = a.Author.ProtoProfile(false)
+
+		pas = append(pas, pa)
+	}
+
+	return &pb.ArticlesResponse{Articles: pas, ArticlesCount: int32(len(pas))}, nil
+}
+
+// PublishArticle publishes a draft article now or schedules it
+func (h *Handler) PublishArticle(ctx context.Context, req *pb.PublishArticleRequest) (*pb.ArticleResponse, error) {
+	h = h.withContext(ctx)
+
+	article, err := h.getOwnArticle(ctx, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	if article.IsPublished() {
+		msg := "article is already published"
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.FailedPrecondition, msg)
+	}
+
+	now := time.Now()
+	if req.GetPublishAt() == "" {
+		article.Publish(now)
+	} else {
+		publishAt, err := parseTime(req.GetPublishAt())
+		if err != nil {
+			msg := "invalid publishAt"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.InvalidArgument, msg)
+		}
+
+		if publishAt.After(now) {
+			article.Schedule(publishAt)
+		} else {
+			article.Publish(now)
+		}
+	}
+
+	if err := h.as.Update(article); err != nil {
+		h.logger.Error().Err(err).Msg("failed to publish article")
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	if article.IsPublished() {
+		h.publishArticle(article)
+		h.triggerArticleWebhooks(model.EventArticleCreated, article, nil)
+	}
+
+	// get whether the article is current user's favorite
+	favorited, err := h.as.IsFavorited(article, &article.Author)
+	if err != nil {
+		msg := "failed to get favorited status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa := article.ProtoArticle(favorited)
+	pa.Author = article.Author.ProtoProfile(false)
+
+	return &pb.ArticleResponse{Article: pa}, nil
+}
+
+// PublishScheduledArticles publishes scheduled articles whose publish time has come
+func (h *Handler) PublishScheduledArticles(now time.Time) error {
+	as, err := h.as.PublishDueArticles(now)
+	if err != nil {
+		return fmt.Errorf("failed to publish scheduled articles: %w", err)
+	}
+
+	if len(as) > 0 {
+		h.logger.Info().Int("count", len(as)).Msg("published scheduled articles")
+	}
+
+	for i := range as {
+		h.publishArticle(&as[i])
+		h.triggerArticleWebhooks(model.EventArticleCreated, &as[i], nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDraftArticles(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	if err := h.us.Follow(&barUser, &fooUser); err != nil {
+		t.Fatalf("failed to create initial user relationship: %v", err)
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	// create a draft
+	resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "draft",
+			Description: "draft",
+			Body:        "draft",
+			TagList:     []string{"draft-tag"},
+			Status:      model.ArticleStatusDraft,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create draft article: %v", err)
+	}
+	draft := resp.GetArticle()
+	assert.Equal(t, model.ArticleStatusDraft, draft.GetStatus())
+	assert.Empty(t, draft.GetPublishAt())
+
+	// drafts are hidden from other users
+	_, err = h.GetArticle(barCtx, &pb.GetArticleRequest{Slug: draft.GetSlug()})
+	assert.Error(t, err)
+	_, err = h.GetArticle(context.Background(), &pb.GetArticleRequest{Slug: draft.GetSlug()})
+	assert.Error(t, err)
+	_, err = h.GetComments(barCtx, &pb.GetCommentsRequest{Slug: draft.GetSlug()})
+	assert.Error(t, err)
+
+	got, err := h.GetArticle(fooCtx, &pb.GetArticleRequest{Slug: draft.GetSlug()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, draft.GetTitle(), got.GetArticle().GetTitle())
+	}
+
+	articles, err := h.GetArticles(context.Background(), &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, articles.GetArticles())
+	}
+
+	feed, err := h.GetFeedArticles(barCtx, &pb.GetFeedArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, feed.GetArticles())
+	}
+
+	tags, err := h.GetTags(context.Background(), &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, tags.GetTags())
+	}
+
+	drafts, err := h.GetDraftArticles(fooCtx, &pb.GetDraftArticlesRequest{})
+	if assert.NoError(t, err) && assert.Len(t, drafts.GetArticles(), 1) {
+		assert.Equal(t, draft.GetSlug(), drafts.GetArticles()[0].GetSlug())
+	}
+
+	drafts, err = h.GetDraftArticles(barCtx, &pb.GetDraftArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, drafts.GetArticles())
+	}
+
+	// only the author can publish
+	_, err = h.PublishArticle(barCtx, &pb.PublishArticleRequest{Slug: draft.GetSlug()})
+	assert.Error(t, err)
+
+	published, err := h.PublishArticle(fooCtx, &pb.PublishArticleRequest{Slug: draft.GetSlug()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, model.ArticleStatusPublished, published.GetArticle().GetStatus())
+		assert.NotEmpty(t, published.GetArticle().GetPublishAt())
+	}
+
+	_, err = h.PublishArticle(fooCtx, &pb.PublishArticleRequest{Slug: draft.GetSlug()})
+	assert.Error(t, err)
+
+	got, err = h.GetArticle(barCtx, &pb.GetArticleRequest{Slug: draft.GetSlug()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, draft.GetTitle(), got.GetArticle().GetTitle())
+	}
+
+	feed, err = h.GetFeedArticles(barCtx, &pb.GetFeedArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Len(t, feed.GetArticles(), 1)
+	}
+
+	tags, err = h.GetTags(context.Background(), &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"draft-tag"}, tags.GetTags())
+	}
+}
+
+func TestScheduledArticles(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	if err := h.us.Create(&fooUser); err != nil {
+		t.Fatalf("failed to create initial user record: %v", err)
+	}
+
+	token, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := ctxWithToken(context.Background(), token)
+
+	publishAt := time.Now().Add(time.Hour)
+	tests := []struct {
+		title    string
+		req      *pb.CreateAritcleRequest
+		expected string
+		hasError bool
+	}{
+		{
+			"create scheduled article: success",
+			&pb.CreateAritcleRequest{
+				Article: &pb.CreateAritcleRequest_Article{
+					Title:     "scheduled",
+					Body:      "scheduled",
+					TagList:   []string{"foo"},
+					Status:    model.ArticleStatusScheduled,
+					PublishAt: publishAt.Format(time.RFC3339),
+				},
+			},
+			model.ArticleStatusScheduled,
+			false,
+		},
+		{
+			"create scheduled article in the past: published immediately",
+			&pb.CreateAritcleRequest{
+				Article: &pb.CreateAritcleRequest_Article{
+					Title:     "past",
+					Body:      "past",
+					TagList:   []string{"foo"},
+					Status:    model.ArticleStatusScheduled,
+					PublishAt: time.Now().Add(-time.Hour).Format(time.RFC3339),
+				},
+			},
+			model.ArticleStatusPublished,
+			false,
+		},
+		{
+			"create scheduled article: no publishAt",
+			&pb.CreateAritcleRequest{
+				Article: &pb.CreateAritcleRequest_Article{
+					Title:   "scheduled",
+					Body:    "scheduled",
+					TagList: []string{"foo"},
+					Status:  model.ArticleStatusScheduled,
+				},
+			},
+			"",
+			true,
+		},
+		{
+			"create article: unknown status",
+			&pb.CreateAritcleRequest{
+				Article: &pb.CreateAritcleRequest_Article{
+					Title:   "unknown",
+					Body:    "unknown",
+					TagList: []string{"foo"},
+					Status:  "archived",
+				},
+			},
+			"",
+			true,
+		},
+		{
+			"create article: invalid publishAt",
+			&pb.CreateAritcleRequest{
+				Article: &pb.CreateAritcleRequest_Article{
+					Title:     "invalid",
+					Body:      "invalid",
+					TagList:   []string{"foo"},
+					Status:    model.ArticleStatusScheduled,
+					PublishAt: "tomorrow",
+				},
+			},
+			"",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		resp, err := h.CreateArticle(ctx, tt.req)
+		if tt.hasError {
+			if err == nil {
+				t.Errorf("%q expected to fail, but succeeded.", tt.title)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q expected to succeed, but failed. %v", tt.title, err)
+			t.FailNow()
+		}
+
+		assert.Equal(t, tt.expected, resp.GetArticle().GetStatus(), tt.title)
+	}
+
+	articles, err := h.GetArticles(ctx, &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) && assert.Len(t, articles.GetArticles(), 1) {
+		assert.Equal(t, "past", articles.GetArticles()[0].GetTitle())
+	}
+
+	// not due yet
+	if err := h.PublishScheduledArticles(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	articles, err = h.GetArticles(ctx, &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Len(t, articles.GetArticles(), 1)
+	}
+
+	if err := h.PublishScheduledArticles(publishAt.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	articles, err = h.GetArticles(ctx, &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Len(t, articles.GetArticles(), 2)
+	}
+
+	drafts, err := h.GetDraftArticles(ctx, &pb.GetDraftArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, drafts.GetArticles())
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Metadata keys of entity tags. The gateway maps them from and to
+// the ETag and If-Match HTTP headers.
+const (
+	etagKey    = "etag"
+	ifMatchKey = "if-match"
+)
+
+// entityTag returns the strong entity tag of the response, which is a hash of its contents.
+// Contents include update times, so the tag changes whenever the response does.
+func entityTag(m proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:16])), nil
+}
+
+// UnaryETagInterceptor sends the entity tag of successful responses in the header metadata
+func UnaryETagInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	m, ok := resp.(proto.Message)
+	if !ok {
+		return resp, nil
+	}
+
+	tag, err := entityTag(m)
+	if err != nil {
+		// responses are served without tags rather than failing
+		return resp, nil
+	}
+	// fails only if the header is already sent
+	grpc.SetHeader(ctx, metadata.Pairs(etagKey, tag))
+
+	return resp, nil
+}
+
+// matchEntityTag returns whether the list of entity tags in a precondition has the tag,
+// with the strong comparison. "*" matches any tag.
+func matchEntityTag(list, tag string) bool {
+	for _, t := range strings.Split(list, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || (t == tag && !strings.HasPrefix(t, "W/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkArticleIfMatch checks the If-Match precondition of the request on the article,
+// comparing it with the tag of the article as the current user gets it.
+// It passes if the request has no precondition.
+func (h *Handler) checkArticleIfMatch(ctx context.Context, slug string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	conds := md.Get(ifMatchKey)
+	if len(conds) == 0 {
+		return nil
+	}
+
+	resp, err := h.GetArticle(ctx, &pb.GetArticleRequest{Slug: slug})
+	if err != nil {
+		return err
+	}
+
+	tag, err := entityTag(resp)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("failed to compute entity tag")
+		return status.Error(codes.Aborted, "internal server error")
+	}
+
+	for _, c := range conds {
+		if matchEntityTag(c, tag) {
+			return nil
+		}
+	}
+
+	msg := fmt.Sprintf("article (slug=%s) was modified since %s", slug, strings.Join(conds, ", "))
+	h.logger.Error().Msg(msg)
+	return status.Error(codes.FailedPrecondition, "precondition failed")
+}
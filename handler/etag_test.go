@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// headerStream records header metadata set by handlers
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(md metadata.MD) error { return nil }
+
+func TestMatchEntityTag(t *testing.T) {
+	for _, tt := range []struct {
+		list string
+		tag  string
+		want bool
+	}{
+		{`"a"`, `"a"`, true},
+		{`"b", "a"`, `"a"`, true},
+		{`"b"`, `"a"`, false},
+		{`*`, `"a"`, true},
+		{`W/"a"`, `"a"`, false},
+	} {
+		assert.Equal(t, tt.want, matchEntityTag(tt.list, tt.tag), tt.list)
+	}
+}
+
+func TestArticleETag(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+	if err := h.us.Create(&fooUser); err != nil {
+		t.Fatalf("failed to create initial user record: %v", err)
+	}
+
+	token, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := ctxWithToken(context.Background(), token)
+
+	resp, err := h.CreateArticle(ctx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"go"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	getETag := func() string {
+		s := &headerStream{}
+		_, err := UnaryETagInterceptor(grpc.NewContextWithServerTransportStream(ctx, s),
+			&pb.GetArticleRequest{Slug: slug}, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return h.GetArticle(ctx, req.(*pb.GetArticleRequest))
+			})
+		if err != nil {
+			t.Fatalf("failed to get article: %v", err)
+		}
+		if len(s.header.Get(etagKey)) != 1 {
+			t.Fatalf("etag is not set: %v", s.header)
+		}
+		return s.header.Get(etagKey)[0]
+	}
+
+	first := getETag()
+	assert.Equal(t, first, getETag(), "tags are stable")
+
+	withIfMatch := func(tag string) context.Context {
+		md, _ := metadata.FromIncomingContext(ctx)
+		return metadata.NewIncomingContext(ctx, metadata.Join(md, metadata.Pairs(ifMatchKey, tag)))
+	}
+
+	// the first update wins, and the second one from the same version fails
+	tests := []struct {
+		title string
+		ctx   context.Context
+		body  string
+		code  codes.Code
+	}{
+		{"update article: no precondition", ctx, "body 1", codes.OK},
+		{"update article: stale tag", withIfMatch(first), "body 2", codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		_, err := h.UpdateArticle(tt.ctx, &pb.UpdateArticleRequest{
+			Article: &pb.UpdateArticleRequest_Article{Slug: slug, Body: tt.body},
+		})
+		assert.Equal(t, tt.code, status.Code(err), tt.title)
+	}
+
+	second := getETag()
+	assert.NotEqual(t, first, second, "tags change along with contents")
+
+	_, err = h.UpdateArticle(withIfMatch(second), &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: slug, Body: "body 3"},
+	})
+	assert.NoError(t, err, "update article: current tag")
+
+	_, err = h.DeleteArticle(withIfMatch(second), &pb.DeleteArticleRequest{Slug: slug})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "delete article: stale tag")
+
+	_, err = h.DeleteArticle(withIfMatch("*"), &pb.DeleteArticleRequest{Slug: slug})
+	assert.NoError(t, err, "delete article: any tag")
+}
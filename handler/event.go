@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"time"
+)
+
+// DispatchEvents publishes domain events in the outbox to sinks
+func (h *Handler) DispatchEvents(now time.Time) error {
+	return h.ev.Dispatch(now)
+}
+
+// PruneEvents deletes dispatched events older than the retention period
+func (h *Handler) PruneEvents(now time.Time) error {
+	n, err := h.ev.Prune(now.Add(-h.cfg.EventRetention))
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		h.logger.Info().Int64("count", n).Msg("pruned dispatched events")
+	}
+	return nil
+}
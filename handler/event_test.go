@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/events"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakySink fails to publish events of the type once
+type flakySink struct {
+	failType string
+	failed   bool
+}
+
+func (s *flakySink) Publish(ctx context.Context, e events.Event) error {
+	if e.Type == s.failType && !s.failed {
+		s.failed = true
+		return errors.New("temporary failure")
+	}
+	return nil
+}
+
+func eventTypes(es []events.Event) []string {
+	types := make([]string, 0, len(es))
+	for _, e := range es {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestDispatchEvents(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	dir, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs, err := events.NewFileSink(filepath.Join(dir, "events.ndjson"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	ms := events.NewMemorySink()
+	h.ev.AddSink(&flakySink{failType: model.EventArticleUpdated})
+	h.ev.AddSink(ms)
+	h.ev.AddSink(fs)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	_, err = h.UpdateArticle(fooCtx, &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: slug, Title: "new title"},
+	})
+	if err != nil {
+		t.Fatalf("failed to update article: %v", err)
+	}
+
+	comment, err := h.CreateComment(barCtx, &pb.CreateCommentRequest{
+		Slug:    slug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	if _, err := h.FollowUser(barCtx, &pb.FollowRequest{Username: "foo"}); err != nil {
+		t.Fatalf("failed to follow user: %v", err)
+	}
+
+	_, err = h.DeleteComment(barCtx, &pb.DeleteCommentRequest{Slug: slug, Id: comment.GetComment().GetId()})
+	if err != nil {
+		t.Fatalf("failed to delete comment: %v", err)
+	}
+
+	if _, err := h.FavoriteArticle(barCtx, &pb.FavoriteArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+
+	// whole seconds as DATETIME columns keep
+	now := time.Now().Truncate(time.Second)
+
+	// events of the article after the failed one are held back
+	err = h.DispatchEvents(now)
+	assert.Error(t, err)
+	assert.Equal(t, []string{
+		model.EventArticleCreated,
+		model.EventUserFollowed,
+	}, eventTypes(ms.Events()))
+
+	// until the failed one is retried after backoff, while other aggregates go on
+	if _, err := h.FollowUser(fooCtx, &pb.FollowRequest{Username: "bar"}); err != nil {
+		t.Fatalf("failed to follow user: %v", err)
+	}
+	if err := h.DispatchEvents(now); err != nil {
+		t.Fatalf("failed to dispatch events: %v", err)
+	}
+	assert.Equal(t, []string{
+		model.EventArticleCreated,
+		model.EventUserFollowed,
+		model.EventUserFollowed,
+	}, eventTypes(ms.Events()))
+
+	// and dispatched in order on the retry
+	if err := h.DispatchEvents(now.Add(events.Backoff(1))); err != nil {
+		t.Fatalf("failed to dispatch events: %v", err)
+	}
+	got := ms.Events()
+	assert.Equal(t, []string{
+		model.EventArticleCreated,
+		model.EventUserFollowed,
+		model.EventUserFollowed,
+		model.EventArticleUpdated,
+		model.EventCommentCreated,
+		model.EventCommentDeleted,
+		model.EventArticleFavorited,
+	}, eventTypes(got))
+
+	for _, e := range got {
+		var p struct {
+			ArticleID uint `json:"articleId"`
+			UserID    uint `json:"userId"`
+		}
+		if !assert.NoError(t, json.Unmarshal(e.Payload, &p), e.Type) {
+			continue
+		}
+
+		switch e.AggregateType {
+		case model.AggregateArticle:
+			assert.Equal(t, slug, fmt.Sprintf("%d", e.AggregateID), e.Type)
+			assert.Equal(t, e.AggregateID, p.ArticleID, e.Type)
+		case model.AggregateUser:
+			assert.Equal(t, e.AggregateID, p.UserID, e.Type)
+		}
+	}
+
+	// dispatched events are not published again
+	if err := h.DispatchEvents(now.Add(events.Backoff(1))); err != nil {
+		t.Fatalf("failed to dispatch events: %v", err)
+	}
+	assert.Len(t, ms.Events(), len(got))
+
+	f, err := os.Open(filepath.Join(dir, "events.ndjson"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var lines []events.Event
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var e events.Event
+		if assert.NoError(t, json.Unmarshal(sc.Bytes(), &e)) {
+			lines = append(lines, e)
+		}
+	}
+	assert.Equal(t, eventTypes(got), eventTypes(lines))
+}
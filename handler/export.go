@@ -0,0 +1,438 @@
+package handler
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the maximum size of chunks of the export archive
+const exportChunkSize = 32 << 10
+
+// exportPageSize is the number of records read from the database at once,
+// not to load all data of large accounts into memory
+const exportPageSize = 100
+
+type exportProfile struct {
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	Bio            string `json:"bio"`
+	Image          string `json:"image"`
+	Admin          bool   `json:"admin"`
+	FollowersCount int32  `json:"followersCount"`
+	FollowingCount int32  `json:"followingCount"`
+	ArticlesCount  int32  `json:"articlesCount"`
+	FavoritesCount int32  `json:"favoritesCount"`
+	CreatedAt      string `json:"createdAt"`
+	UpdatedAt      string `json:"updatedAt"`
+}
+
+type exportArticle struct {
+	Slug           string           `json:"slug"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	Body           string           `json:"body"`
+	TagList        []string         `json:"tagList"`
+	Status         string           `json:"status"`
+	PublishAt      string           `json:"publishAt,omitempty"`
+	FavoritesCount int32            `json:"favoritesCount"`
+	CreatedAt      string           `json:"createdAt"`
+	UpdatedAt      string           `json:"updatedAt"`
+	Revisions      []exportRevision `json:"revisions"`
+}
+
+type exportRevision struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Body        string `json:"body"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+type exportComment struct {
+	ID        string `json:"id"`
+	Slug      string `json:"slug"` // article of the comment
+	Body      string `json:"body"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type exportFavorite struct {
+	Slug   string `json:"slug"`
+	Title  string `json:"title"`
+	Author string `json:"author"`
+}
+
+type exportSettings struct {
+	BlockedUsers []string `json:"blockedUsers"`
+	MutedUsers   []string `json:"mutedUsers"`
+	Bookmarks    []string `json:"bookmarks"` // slugs of bookmarked articles
+}
+
+// ExportMyData streams a ZIP archive of current user's profile, articles, comments,
+// follows, favorites and settings. The archive is written while it is sent
+// in chunks, so it is never held in memory as a whole.
+func (h *Handler) ExportMyData(req *pb.Empty, stream pb.Users_ExportMyDataServer) error {
+	h = h.withContext(stream.Context())
+
+	currentUser, err := h.currentUser(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriterSize(&exportWriter{stream: stream}, exportChunkSize)
+	zw := zip.NewWriter(bw)
+	e := &exporter{h: h, zw: zw, user: currentUser, now: time.Now()}
+
+	for _, f := range []func() error{
+		e.writeProfile,
+		e.writeArticles,
+		e.writeComments,
+		e.writeFollows,
+		e.writeFavorites,
+		e.writeSettings,
+	} {
+		if err := f(); err != nil {
+			msg := "failed to export data"
+			h.logger.Error().Err(err).Uint("user_id", currentUser.ID).Msg(msg)
+			return status.Error(codes.Aborted, "internal server error")
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		h.logger.Error().Err(err).Msg("failed to close export archive")
+		return status.Error(codes.Aborted, "internal server error")
+	}
+
+	if err := bw.Flush(); err != nil {
+		h.logger.Error().Err(err).Msg("failed to send export archive")
+		return status.Error(codes.Aborted, "internal server error")
+	}
+
+	return nil
+}
+
+// exportWriter sends written bytes to the stream in chunks
+type exportWriter struct {
+	stream pb.Users_ExportMyDataServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		size := len(p)
+		if size > exportChunkSize {
+			size = exportChunkSize
+		}
+
+		if err := w.stream.Send(&pb.ExportMyDataResponse{Chunk: p[:size]}); err != nil {
+			return n, err
+		}
+		n += size
+		p = p[size:]
+	}
+	return n, nil
+}
+
+// exporter writes files of the user's data into the archive
+type exporter struct {
+	h    *Handler
+	zw   *zip.Writer
+	user *model.User
+	now  time.Time
+}
+
+// create adds a file to the archive
+func (e *exporter) create(name string) (io.Writer, error) {
+	return e.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: e.now,
+	})
+}
+
+// writeJSON adds a JSON file of v to the archive
+func (e *exporter) writeJSON(name string, v interface{}) error {
+	w, err := e.create(name)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// writeJSONArray adds a JSON file of an array whose elements are read page by page.
+// next returns the elements at the offset, fewer than exportPageSize on the last page.
+func (e *exporter) writeJSONArray(name string, next func(offset int64) ([]interface{}, error)) error {
+	w, err := e.create(name)
+	if err != nil {
+		return err
+	}
+
+	sep := "[\n  "
+	for offset := int64(0); ; offset += exportPageSize {
+		vs, err := next(offset)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range vs {
+			b, err := json.MarshalIndent(v, "  ", "  ")
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, sep); err != nil {
+				return err
+			}
+			if _, err := w.Write(b); err != nil {
+				return err
+			}
+			sep = ",\n  "
+		}
+
+		if len(vs) < exportPageSize {
+			break
+		}
+	}
+
+	if sep == "[\n  " {
+		_, err = io.WriteString(w, "[]\n")
+	} else {
+		_, err = io.WriteString(w, "\n]\n")
+	}
+	return err
+}
+
+func (e *exporter) writeProfile() error {
+	u := e.user
+	return e.writeJSON("profile.json", exportProfile{
+		Username:       u.Username,
+		Email:          u.Email,
+		Bio:            u.Bio,
+		Image:          u.Image,
+		Admin:          u.Admin,
+		FollowersCount: u.FollowersCount,
+		FollowingCount: u.FollowingCount,
+		ArticlesCount:  u.ArticlesCount,
+		FavoritesCount: u.FavoritesCount,
+		CreatedAt:      u.CreatedAt.Format(model.ISO8601),
+		UpdatedAt:      u.UpdatedAt.Format(model.ISO8601),
+	})
+}
+
+// writeArticles adds a JSON file with revisions and a Markdown copy of each article
+func (e *exporter) writeArticles() error {
+	for offset := int64(0); ; offset += exportPageSize {
+		as, err := e.h.as.GetAuthoredArticles(e.user, exportPageSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for i := range as {
+			if err := e.writeArticle(&as[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(as) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+func (e *exporter) writeArticle(a *model.Article) error {
+	ea := exportArticle{
+		Slug:           fmt.Sprintf("%d", a.ID),
+		Title:          a.Title,
+		Description:    a.Description,
+		Body:           a.Body,
+		TagList:        make([]string, 0, len(a.Tags)),
+		Status:         a.Status,
+		FavoritesCount: a.FavoritesCount,
+		CreatedAt:      a.CreatedAt.Format(model.ISO8601),
+		UpdatedAt:      a.UpdatedAt.Format(model.ISO8601),
+		Revisions:      []exportRevision{},
+	}
+	for _, t := range a.Tags {
+		ea.TagList = append(ea.TagList, t.Name)
+	}
+	if a.PublishAt != nil {
+		ea.PublishAt = a.PublishAt.Format(model.ISO8601)
+	}
+
+	for offset := int64(0); ; offset += exportPageSize {
+		rs, err := e.h.as.GetRevisions(a, exportPageSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range rs {
+			ea.Revisions = append(ea.Revisions, exportRevision{
+				ID:          fmt.Sprintf("%d", r.ID),
+				Title:       r.Title,
+				Description: r.Description,
+				Body:        r.Body,
+				CreatedAt:   r.CreatedAt.Format(model.ISO8601),
+			})
+		}
+
+		if len(rs) < exportPageSize {
+			break
+		}
+	}
+
+	if err := e.writeJSON(fmt.Sprintf("articles/%s.json", ea.Slug), ea); err != nil {
+		return err
+	}
+
+	w, err := e.create(fmt.Sprintf("articles/%s.md", ea.Slug))
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, articleMarkdown(a))
+	return err
+}
+
+// articleMarkdown returns a Markdown document of the article
+func articleMarkdown(a *model.Article) string {
+	md := fmt.Sprintf("# %s\n\n", a.Title)
+	if a.Description != "" {
+		md += fmt.Sprintf("> %s\n\n", a.Description)
+	}
+	md += a.Body + "\n"
+
+	if len(a.Tags) > 0 {
+		md += "\nTags:"
+		for _, t := range a.Tags {
+			md += " #" + t.Name
+		}
+		md += "\n"
+	}
+
+	return md
+}
+
+func (e *exporter) writeComments() error {
+	return e.writeJSONArray("comments.json", func(offset int64) ([]interface{}, error) {
+		cs, err := e.h.as.GetAuthoredComments(e.user, exportPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		vs := make([]interface{}, 0, len(cs))
+		for _, c := range cs {
+			vs = append(vs, exportComment{
+				ID:        fmt.Sprintf("%d", c.ID),
+				Slug:      fmt.Sprintf("%d", c.ArticleID),
+				Body:      c.Body,
+				CreatedAt: c.CreatedAt.Format(model.ISO8601),
+				UpdatedAt: c.UpdatedAt.Format(model.ISO8601),
+			})
+		}
+		return vs, nil
+	})
+}
+
+// writeFollows adds usernames of users the user follows and users following the user
+func (e *exporter) writeFollows() error {
+	for _, l := range []struct {
+		name string
+		get  func(*model.User, int64, int64) ([]model.User, error)
+	}{
+		{"following.json", e.h.us.GetFollowing},
+		{"followers.json", e.h.us.GetFollowers},
+	} {
+		get := l.get
+		err := e.writeJSONArray(l.name, func(offset int64) ([]interface{}, error) {
+			us, err := get(e.user, exportPageSize, offset)
+			if err != nil {
+				return nil, err
+			}
+
+			vs := make([]interface{}, 0, len(us))
+			for _, u := range us {
+				vs = append(vs, u.Username)
+			}
+			return vs, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) writeFavorites() error {
+	return e.writeJSONArray("favorites.json", func(offset int64) ([]interface{}, error) {
+		as, err := e.h.as.GetFavoritedArticles(e.user, exportPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		vs := make([]interface{}, 0, len(as))
+		for _, a := range as {
+			vs = append(vs, exportFavorite{
+				Slug:   fmt.Sprintf("%d", a.ID),
+				Title:  a.Title,
+				Author: a.Author.Username,
+			})
+		}
+		return vs, nil
+	})
+}
+
+// writeSettings adds blocked users, muted users and bookmarks
+func (e *exporter) writeSettings() error {
+	s := exportSettings{
+		BlockedUsers: []string{},
+		MutedUsers:   []string{},
+		Bookmarks:    []string{},
+	}
+
+	for _, l := range []struct {
+		get   func(*model.User, int64, int64) ([]model.User, error)
+		names *[]string
+	}{
+		{e.h.us.GetBlockedUsers, &s.BlockedUsers},
+		{e.h.us.GetMutedUsers, &s.MutedUsers},
+	} {
+		for offset := int64(0); ; offset += exportPageSize {
+			us, err := l.get(e.user, exportPageSize, offset)
+			if err != nil {
+				return err
+			}
+			for _, u := range us {
+				*l.names = append(*l.names, u.Username)
+			}
+			if len(us) < exportPageSize {
+				break
+			}
+		}
+	}
+
+	for offset := int64(0); ; offset += exportPageSize {
+		as, err := e.h.as.GetBookmarkedArticles(e.user, exportPageSize, offset)
+		if err != nil {
+			return err
+		}
+		for _, a := range as {
+			s.Bookmarks = append(s.Bookmarks, fmt.Sprintf("%d", a.ID))
+		}
+		if len(as) < exportPageSize {
+			break
+		}
+	}
+
+	return e.writeJSON("settings.json", s)
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// exportStream is a fake server stream of ExportMyData
+type exportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(resp *pb.ExportMyDataResponse) error {
+	// chunks are reused by the sender like grpc serializes them on send
+	s.chunks = append(s.chunks, append([]byte{}, resp.GetChunk()...))
+	return nil
+}
+
+func TestExportMyData(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+		Bio:      "foo's bio",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	slugs := map[string]string{}
+	for _, a := range []struct {
+		user   string
+		title  string
+		body   string
+		status string
+	}{
+		{"foo", "foo's article", "hello", ""},
+		{"foo", "foo's draft", "draft", model.ArticleStatusDraft},
+		{"bar", "bar's article", "bar", ""},
+	} {
+		resp, err := h.CreateArticle(ctxs[a.user], &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       a.title,
+				Description: "description",
+				Body:        a.body,
+				TagList:     []string{"export"},
+				Status:      a.status,
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs[a.title] = resp.GetArticle().GetSlug()
+	}
+
+	_, err := h.UpdateArticle(ctxs["foo"], &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: slugs["foo's article"], Body: "hello again"},
+	})
+	if err != nil {
+		t.Fatalf("failed to update article: %v", err)
+	}
+
+	if _, err := h.CreateComment(ctxs["foo"], &pb.CreateCommentRequest{
+		Slug:    slugs["bar's article"],
+		Comment: &pb.CreateCommentRequest_Comment{Body: "nice"},
+	}); err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+	if _, err := h.FollowUser(ctxs["foo"], &pb.FollowRequest{Username: "bar"}); err != nil {
+		t.Fatalf("failed to follow user: %v", err)
+	}
+	if _, err := h.FavoriteArticle(ctxs["foo"], &pb.FavoriteArticleRequest{Slug: slugs["bar's article"]}); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+	if _, err := h.BookmarkArticle(ctxs["foo"], &pb.BookmarkArticleRequest{Slug: slugs["bar's article"]}); err != nil {
+		t.Fatalf("failed to bookmark article: %v", err)
+	}
+	if _, err := h.MuteUser(ctxs["foo"], &pb.MuteRequest{Username: "bar"}); err != nil {
+		t.Fatalf("failed to mute user: %v", err)
+	}
+
+	// unauthenticated
+	err = h.ExportMyData(&pb.Empty{}, &exportStream{ctx: context.Background()})
+	assert.Error(t, err)
+
+	stream := &exportStream{ctx: ctxs["foo"]}
+	if err := h.ExportMyData(&pb.Empty{}, stream); err != nil {
+		t.Fatalf("failed to export data: %v", err)
+	}
+
+	var archive []byte
+	for _, c := range stream.chunks {
+		archive = append(archive, c...)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("failed to read archive: %v", err)
+	}
+
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = b
+	}
+
+	fooSlug, draftSlug, barSlug := slugs["foo's article"], slugs["foo's draft"], slugs["bar's article"]
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	assert.ElementsMatch(t, []string{
+		"profile.json",
+		"articles/" + fooSlug + ".json",
+		"articles/" + fooSlug + ".md",
+		"articles/" + draftSlug + ".json",
+		"articles/" + draftSlug + ".md",
+		"comments.json",
+		"following.json",
+		"followers.json",
+		"favorites.json",
+		"settings.json",
+	}, names)
+
+	var profile exportProfile
+	if assert.NoError(t, json.Unmarshal(files["profile.json"], &profile)) {
+		assert.Equal(t, "foo", profile.Username)
+		assert.Equal(t, "foo@example.com", profile.Email)
+		assert.Equal(t, "foo's bio", profile.Bio)
+	}
+
+	var article exportArticle
+	if assert.NoError(t, json.Unmarshal(files["articles/"+fooSlug+".json"], &article)) {
+		assert.Equal(t, "hello again", article.Body)
+		assert.Equal(t, []string{"export"}, article.TagList)
+		if assert.Len(t, article.Revisions, 2) {
+			assert.Equal(t, "hello again", article.Revisions[0].Body)
+			assert.Equal(t, "hello", article.Revisions[1].Body)
+		}
+	}
+	assert.Equal(t, "# foo's article\n\n> description\n\nhello again\n\nTags: #export\n",
+		string(files["articles/"+fooSlug+".md"]))
+
+	var draft exportArticle
+	if assert.NoError(t, json.Unmarshal(files["articles/"+draftSlug+".json"], &draft)) {
+		assert.Equal(t, model.ArticleStatusDraft, draft.Status)
+	}
+
+	var comments []exportComment
+	if assert.NoError(t, json.Unmarshal(files["comments.json"], &comments)) && assert.Len(t, comments, 1) {
+		assert.Equal(t, barSlug, comments[0].Slug)
+		assert.Equal(t, "nice", comments[0].Body)
+	}
+
+	var following, followers []string
+	if assert.NoError(t, json.Unmarshal(files["following.json"], &following)) {
+		assert.Equal(t, []string{"bar"}, following)
+	}
+	if assert.NoError(t, json.Unmarshal(files["followers.json"], &followers)) {
+		assert.Empty(t, followers)
+	}
+
+	var favorites []exportFavorite
+	if assert.NoError(t, json.Unmarshal(files["favorites.json"], &favorites)) && assert.Len(t, favorites, 1) {
+		assert.Equal(t, exportFavorite{Slug: barSlug, Title: "bar's article", Author: "bar"}, favorites[0])
+	}
+
+	var settings exportSettings
+	if assert.NoError(t, json.Unmarshal(files["settings.json"], &settings)) {
+		assert.Empty(t, settings.BlockedUsers)
+		assert.Equal(t, []string{"bar"}, settings.MutedUsers)
+		assert.Equal(t, []string{barSlug}, settings.Bookmarks)
+	}
+}
+
+func TestExportWriter(t *testing.T) {
+	stream := &exportStream{ctx: context.Background()}
+	w := &exportWriter{stream: stream}
+
+	data := bytes.Repeat([]byte("x"), 2*exportChunkSize+1)
+	n, err := w.Write(data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, len(data), n)
+	}
+
+	if assert.Len(t, stream.chunks, 3) {
+		assert.Len(t, stream.chunks[0], exportChunkSize)
+		assert.Len(t, stream.chunks[1], exportChunkSize)
+		assert.Len(t, stream.chunks[2], 1)
+	}
+}
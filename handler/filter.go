@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raahii/golang-grpc-realworld-example/filter"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// filterContent runs the content filter on the text the user is about to save.
+// Rejected content results in an error; callers hold the content for moderation
+// when the decision says so.
+func (h *Handler) filterContent(ctx context.Context, kind string, u *model.User, text string) (filter.Decision, error) {
+	d, err := h.cf.Check(ctx, filter.Content{Kind: kind, UserID: u.ID, Text: text})
+	if err != nil {
+		msg := "failed to filter content"
+		h.logger.Error().Err(err).Msg(msg)
+		return d, status.Error(codes.Aborted, "internal server error")
+	}
+
+	h.logger.Info().Str("kind", kind).Uint("user_id", u.ID).
+		Str("action", d.Action.String()).Str("filter", d.Filter).Str("reason", d.Reason).
+		Msg("content filter decision")
+
+	if d.Action == filter.Reject {
+		return d, status.Error(codes.InvalidArgument, fmt.Sprintf("content rejected: %s", d.Reason))
+	}
+
+	return d, nil
+}
+
+// holdContent puts the content held by the content filter into the moderation queue
+func (h *Handler) holdContent(targetType string, targetID uint, d filter.Decision) error {
+	r := model.NewHeldReport(targetType, targetID, d.Filter, d.Reason)
+	if err := r.Validate(); err != nil {
+		return fmt.Errorf("invalid report of held content: %w", err)
+	}
+
+	// the content is hidden already
+	if _, err := h.rs.Create(r, 0); err != nil {
+		return fmt.Errorf("failed to queue held content: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/filter"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentFilter(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	// quoted in the reason of the decision
+	longWord := strings.Repeat("x", 240)
+
+	h.cf = filter.Chain{
+		filter.NewWordList([]string{"spam"}, filter.Reject),
+		filter.NewWordList([]string{"casino", longWord}, filter.Hold),
+		filter.NewLinks(2, []string{"evil.example"}),
+		filter.NewDuplicates(time.Minute),
+	}
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	adminUser := model.User{
+		Username: "admin",
+		Email:    "admin@example.com",
+		Password: "secret",
+		Admin:    true,
+	}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser, &adminUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	tests := []struct {
+		title    string
+		body     string
+		held     bool
+		hasError bool
+	}{
+		{"clean article: allowed", "hello world", false, false},
+		{"blocked word: rejected", "buy SPAM now", false, true},
+		{"held word: held", "visit my casino", true, false},
+		{"blocked host: rejected", "see https://www.evil.example/page", false, true},
+		{"few links: allowed", "see https://a.example and https://b.example", false, false},
+		{"too many links: held", "https://a.example https://b.example https://c.example", true, false},
+		{"duplicate article: held", "Hello  world", true, false},
+		{"held word longer than report reasons: held", "see " + longWord, true, false},
+	}
+
+	var cleanSlug string
+	var heldSlugs []string
+	for _, tt := range tests {
+		resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       "title",
+				Description: "description",
+				Body:        tt.body,
+				TagList:     []string{"foo"},
+			},
+		})
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if !assert.NoError(t, err, tt.title) {
+			continue
+		}
+		slug := resp.GetArticle().GetSlug()
+
+		// held articles are visible only to their author
+		_, err = h.GetArticle(ctxs["bar"], &pb.GetArticleRequest{Slug: slug})
+		assert.Equal(t, tt.held, err != nil, tt.title)
+
+		_, err = h.GetArticle(ctxs["foo"], &pb.GetArticleRequest{Slug: slug})
+		assert.NoError(t, err, tt.title)
+
+		if tt.held {
+			heldSlugs = append(heldSlugs, slug)
+		} else if cleanSlug == "" {
+			cleanSlug = slug
+		}
+	}
+
+	// held contents wait for staff in the moderation queue
+	queue, err := h.ListModerationQueue(ctxs["admin"], &pb.ListModerationQueueRequest{})
+	if assert.NoError(t, err) && assert.Len(t, queue.GetItems(), len(heldSlugs)) {
+		for i, item := range queue.GetItems() {
+			assert.Equal(t, heldSlugs[i], item.GetTargetId())
+			assert.True(t, item.GetHidden())
+			if assert.Len(t, item.GetReports(), 1) {
+				assert.Nil(t, item.GetReports()[0].GetReporter())
+				assert.Contains(t, item.GetReports()[0].GetReason(), "held by")
+				assert.LessOrEqual(t, utf8.RuneCountInString(item.GetReports()[0].GetReason()), 255)
+			}
+		}
+	}
+
+	// updates are filtered as well
+	_, err = h.UpdateArticle(ctxs["foo"], &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: cleanSlug, Body: "spam"},
+	})
+	assert.Error(t, err)
+
+	_, err = h.UpdateArticle(ctxs["foo"], &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: cleanSlug, Title: "new title"},
+	})
+	assert.NoError(t, err, "unchanged body is not a duplicate")
+
+	articleID, err := strconv.Atoi(cleanSlug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	article, err := h.as.GetByID(uint(articleID))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hello world", article.Body)
+		assert.False(t, article.IsHidden())
+	}
+
+	// comments
+	comments := []struct {
+		title    string
+		body     string
+		held     bool
+		hasError bool
+	}{
+		{"clean comment: allowed", "nice article", false, false},
+		{"blocked word: rejected", "spam", false, true},
+		{"held word: held", "casino", true, false},
+		{"duplicate comment: held", "Nice article", true, false},
+	}
+
+	for _, tt := range comments {
+		resp, err := h.CreateComment(ctxs["bar"], &pb.CreateCommentRequest{
+			Slug:    cleanSlug,
+			Comment: &pb.CreateCommentRequest_Comment{Body: tt.body},
+		})
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if !assert.NoError(t, err, tt.title) {
+			continue
+		}
+
+		got, err := h.GetComments(ctxs["foo"], &pb.GetCommentsRequest{Slug: cleanSlug})
+		if !assert.NoError(t, err, tt.title) {
+			continue
+		}
+		if tt.held {
+			assert.NotContains(t, commentIDs(got.GetComments()), resp.GetComment().GetId(), tt.title)
+		} else {
+			assert.Contains(t, commentIDs(got.GetComments()), resp.GetComment().GetId(), tt.title)
+		}
+	}
+
+	// bios
+	bios := []struct {
+		title    string
+		bio      string
+		hasError bool
+	}{
+		{"blocked word: rejected", "spam", true},
+		{"held word: rejected", "casino", true},
+		{"clean bio: allowed", "hello", false},
+		{"same bio: allowed", "hello", false},
+	}
+
+	for _, tt := range bios {
+		_, err := h.UpdateUser(ctxs["bar"], &pb.UpdateUserRequest{
+			User: &pb.UpdateUserRequest_User{Bio: tt.bio},
+		})
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+		} else {
+			assert.NoError(t, err, tt.title)
+		}
+	}
+
+	u, err := h.us.GetByID(barUser.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hello", u.Bio)
+	}
+}
+
+func TestApproveHeldContent(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	h.cf = filter.NewWordList([]string{"casino"}, filter.Hold)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	adminUser := model.User{
+		Username: "admin",
+		Email:    "admin@example.com",
+		Password: "secret",
+		Admin:    true,
+	}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser, &adminUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	rc := &receiver{status: http.StatusOK}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	_, err := h.CreateWebhook(ctxs["admin"], &pb.CreateWebhookRequest{
+		Webhook: &pb.CreateWebhookRequest_Webhook{
+			Url:    server.URL,
+			Secret: webhookSecret,
+			Events: []string{model.EventArticleCreated, model.EventArticleUpdated, model.EventCommentCreated},
+			Active: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+
+	slugs := map[string]string{}
+	for _, body := range []string{"hello", "casino"} {
+		resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{Title: body, Body: body, TagList: []string{"foo"}},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs[body] = resp.GetArticle().GetSlug()
+	}
+
+	comment, err := h.CreateComment(ctxs["bar"], &pb.CreateCommentRequest{
+		Slug:    slugs["hello"],
+		Comment: &pb.CreateCommentRequest_Comment{Body: "casino"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	_, err = h.UpdateArticle(ctxs["foo"], &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: slugs["hello"], Body: "hello casino"},
+	})
+	if err != nil {
+		t.Fatalf("failed to update article: %v", err)
+	}
+
+	// whole seconds as DATETIME columns keep
+	now := time.Now().Truncate(time.Second)
+	if err := h.DeliverWebhooks(now); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+	if got := webhookEvents(rc.received()); assert.Len(t, got, 1) {
+		assert.Equal(t, model.EventArticleCreated+" hello", got[0])
+	}
+
+	unread, err := h.GetUnreadCount(ctxs["foo"], &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(0), unread.GetCount(), "held comment")
+	}
+
+	// held contents are announced once staff approve them
+	for _, item := range []*pb.ResolveModerationItemRequest{
+		{TargetType: model.ReportTargetArticle, TargetId: slugs["casino"], Action: model.ModerationDismiss},
+		{TargetType: model.ReportTargetArticle, TargetId: slugs["hello"], Action: model.ModerationDismiss},
+		{TargetType: model.ReportTargetComment, TargetId: comment.GetComment().GetId(), Action: model.ModerationDismiss},
+	} {
+		if _, err := h.ResolveModerationItem(ctxs["admin"], item); err != nil {
+			t.Fatalf("failed to resolve %s (id=%s): %v", item.GetTargetType(), item.GetTargetId(), err)
+		}
+	}
+
+	if err := h.DeliverWebhooks(now.Add(time.Second)); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+	assert.Equal(t, []string{
+		model.EventArticleCreated + " hello",
+		model.EventArticleCreated + " casino",
+		model.EventArticleUpdated + " hello",
+		model.EventCommentCreated + " hello",
+	}, webhookEvents(rc.received()))
+
+	unread, err = h.GetUnreadCount(ctxs["foo"], &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(1), unread.GetCount(), "approved comment")
+	}
+}
+
+// webhookEvents returns events of the payloads with titles of their articles
+func webhookEvents(payloads []map[string]interface{}) []string {
+	events := make([]string, 0, len(payloads))
+	for _, p := range payloads {
+		article := p["data"].(map[string]interface{})["article"].(map[string]interface{})
+		events = append(events, p["event"].(string)+" "+article["title"].(string))
+	}
+	return events
+}
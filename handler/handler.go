@@ -1,18 +1,174 @@
 package handler
 
 import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/blob"
+	"github.com/raahii/golang-grpc-realworld-example/events"
+	"github.com/raahii/golang-grpc-realworld-example/filter"
+	"github.com/raahii/golang-grpc-realworld-example/logging"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/raahii/golang-grpc-realworld-example/pubsub"
 	"github.com/raahii/golang-grpc-realworld-example/store"
+	"github.com/raahii/golang-grpc-realworld-example/webhook"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Handler definition
 type Handler struct {
 	logger *zerolog.Logger
-	us     *store.UserStore
-	as     *store.ArticleStore
+	us     *store.CachedUserStore
+	as     *store.CachedArticleStore
+	ns     *store.NotificationStore
+	ws     *store.WebhookStore
+	rs     *store.CachedReportStore
+	bs     blob.Storage
+	ps     pubsub.PubSub
+	wh     *webhook.Sender
+	ev     *events.Dispatcher
+	cf     filter.Filter
+	cfg    Config
+}
+
+// Config is the configuration of the handler
+type Config struct {
+	// ImageBaseURL is the public URL of the gateway serving uploaded images
+	ImageBaseURL string
+	// EventRetention is how long dispatched events are kept
+	EventRetention time.Duration
+	// ReactionKinds is kinds of reactions users can give
+	ReactionKinds []string
+	// AutoHideReports is the number of distinct users whose reports hide the
+	// content until staff review it. Zero disables hiding contents automatically.
+	AutoHideReports int
+	// TrashRetention is how long deleted articles and comments can be restored
+	// before they are purged
+	TrashRetention time.Duration
+	// AccountDeletionMode is what happens to contents of users who delete their accounts
+	AccountDeletionMode string
+}
+
+// DefaultConfig returns the configuration used unless configured otherwise
+func DefaultConfig() Config {
+	return Config{
+		EventRetention:      defaultEventRetention,
+		ReactionKinds:       model.DefaultReactionKinds,
+		AutoHideReports:     defaultAutoHideReports,
+		TrashRetention:      defaultTrashRetention,
+		AccountDeletionMode: AccountDeletionAnonymize,
+	}
+}
+
+// webhookTimeout is the timeout of a webhook request
+const webhookTimeout = 10 * time.Second
+
+// defaultEventRetention is how long dispatched events are kept by default
+const defaultEventRetention = 7 * 24 * time.Hour
+
+// defaultAutoHideReports is the default number of reports which hides the content
+const defaultAutoHideReports = 3
+
+// defaultTrashRetention is how long deleted contents are kept by default
+const defaultTrashRetention = 30 * 24 * time.Hour
+
+// New returns a new handler with logger, database, blob storage, pub/sub, event dispatcher, content filter and configuration
+func New(l *zerolog.Logger, us *store.CachedUserStore, as *store.CachedArticleStore, ns *store.NotificationStore, ws *store.WebhookStore, rs *store.CachedReportStore, bs blob.Storage, ps pubsub.PubSub, ev *events.Dispatcher, cf filter.Filter, cfg Config) *Handler {
+	return &Handler{
+		logger: l,
+		us:     us,
+		as:     as,
+		ns:     ns,
+		ws:     ws,
+		rs:     rs,
+		bs:     bs,
+		ps:     ps,
+		wh:     webhook.NewSender(&http.Client{Timeout: webhookTimeout}),
+		ev:     ev,
+		cf:     cf,
+		cfg:    cfg,
+	}
 }
 
-// New returns a new handler with logger and database
-func New(l *zerolog.Logger, us *store.UserStore, as *store.ArticleStore) *Handler {
-	return &Handler{logger: l, us: us, as: as}
+// withContext returns a copy of the handler which logs with the logger of
+// the request and whose queries are traced in ctx
+func (h *Handler) withContext(ctx context.Context) *Handler {
+	c := *h
+	c.logger = logging.Ctx(ctx, h.logger)
+	c.us = h.us.WithContext(ctx)
+	c.as = h.as.WithContext(ctx)
+	c.ns = h.ns.WithContext(ctx)
+	c.ws = h.ws.WithContext(ctx)
+	c.rs = h.rs.WithContext(ctx)
+	return &c
+}
+
+// currentUser returns the authenticated user
+func (h *Handler) currentUser(ctx context.Context) (*model.User, error) {
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("unauthenticated")
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	currentUser, err := h.us.GetByID(userID)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("current user not found")
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	// tokens of deleted accounts are revoked
+	if currentUser.IsAnonymized() {
+		h.logger.Error().Uint("user_id", currentUser.ID).Msg("token of deleted account")
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	return currentUser, nil
+}
+
+// currentAdmin returns the authenticated user if the user is an admin
+func (h *Handler) currentAdmin(ctx context.Context) (*model.User, error) {
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !currentUser.Admin {
+		h.logger.Error().Uint("user_id", currentUser.ID).Msg("current user is not an admin")
+		return nil, status.Error(codes.PermissionDenied, "forbidden")
+	}
+
+	return currentUser, nil
+}
+
+// AuthFunc rejects requests of suspended users and ones with tokens of deleted accounts.
+// Requests without a valid token pass through and each handler decides whether to accept them.
+func (h *Handler) AuthFunc(ctx context.Context) (context.Context, error) {
+	h = h.withContext(ctx)
+
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		return ctx, nil
+	}
+
+	u, err := h.us.GetByID(userID)
+	if err != nil {
+		return ctx, nil
+	}
+
+	if u.IsAnonymized() {
+		h.logger.Error().Uint("user_id", u.ID).Msg("token of deleted account")
+		return nil, status.Error(codes.Unauthenticated, "token revoked")
+	}
+
+	if u.IsSuspended() {
+		h.logger.Error().Uint("user_id", u.ID).Msg("suspended user attempted to access")
+		return nil, status.Error(codes.PermissionDenied, "account suspended")
+	}
+
+	return ctx, nil
 }
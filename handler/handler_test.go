@@ -4,11 +4,18 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/raahii/golang-grpc-realworld-example/blob"
+	"github.com/raahii/golang-grpc-realworld-example/cache"
 	"github.com/raahii/golang-grpc-realworld-example/db"
+	"github.com/raahii/golang-grpc-realworld-example/events"
+	"github.com/raahii/golang-grpc-realworld-example/filter"
+	"github.com/raahii/golang-grpc-realworld-example/pubsub"
 	"github.com/raahii/golang-grpc-realworld-example/store"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/metadata"
@@ -24,14 +31,30 @@ func setUp(t *testing.T) (*Handler, func(t *testing.T)) {
 		t.Fatal(fmt.Errorf("failed to initialize database: %w", err))
 	}
 
-	us := store.NewUserStore(d)
-	as := store.NewArticleStore(d)
+	// cache everything so that tests go through invalidation
+	c := cache.New(cache.NewMemory(cache.DefaultMemorySize), time.Hour)
+	us := store.NewCachedUserStore(store.NewUserStore(d), c)
+	as := store.NewCachedArticleStore(store.NewArticleStore(d), c)
+	ns := store.NewNotificationStore(d)
+	ws := store.NewWebhookStore(d)
+	rs := store.NewCachedReportStore(store.NewReportStore(d), c)
+	ev := events.NewDispatcher(&l, store.NewOutboxStore(d))
 
-	return New(&l, us, as), func(t *testing.T) {
+	dir, err := ioutil.TempDir("", "images")
+	if err != nil {
+		t.Fatal(fmt.Errorf("failed to create image directory: %w", err))
+	}
+	bs, err := blob.NewLocalStorage(dir)
+	if err != nil {
+		t.Fatal(fmt.Errorf("failed to initialize image storage: %w", err))
+	}
+
+	return New(&l, us, as, ns, ws, rs, bs, pubsub.NewMemory(pubsub.DefaultBufferSize), ev, filter.Chain{}, DefaultConfig()), func(t *testing.T) {
 		err := db.DropTestDB(d)
 		if err != nil {
 			t.Fatal(fmt.Errorf("failed to clean database: %w", err))
 		}
+		os.RemoveAll(dir)
 	}
 }
 
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/blob"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxImageSize is the maximum size of an uploaded image in bytes
+const MaxImageSize = 5 << 20
+
+// MaxImagePixels is the maximum number of pixels of an uploaded image, summed
+// over the frames of an animated GIF. It bounds the memory used to decode it,
+// as a small file can declare huge dimensions.
+const MaxImagePixels = 25 << 20
+
+// MaxGIFFrames is the maximum number of frames of an uploaded GIF
+const MaxGIFFrames = 500
+
+// imageExtensions maps accepted content types to file extensions
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// UploadImage stores an image uploaded in chunks and returns its URL
+func (h *Handler) UploadImage(stream pb.Images_UploadImageServer) error {
+	ctx := stream.Context()
+	h = h.withContext(ctx)
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("unauthenticated")
+		return status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	var (
+		contentType string
+		buf         bytes.Buffer
+	)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			h.logger.Error().Err(err).Msg("failed to receive image chunk")
+			return status.Error(codes.Canceled, "failed to receive image")
+		}
+
+		if contentType == "" {
+			contentType = req.GetContentType()
+			if _, ok := imageExtensions[contentType]; !ok {
+				msg := fmt.Sprintf("unsupported content type: %q", contentType)
+				h.logger.Error().Msg(msg)
+				return status.Error(codes.InvalidArgument, msg)
+			}
+		}
+
+		if buf.Len()+len(req.GetChunk()) > MaxImageSize {
+			msg := fmt.Sprintf("image must be smaller than %d bytes", MaxImageSize)
+			h.logger.Error().Uint("user_id", userID).Msg(msg)
+			return status.Error(codes.InvalidArgument, msg)
+		}
+		buf.Write(req.GetChunk())
+	}
+
+	if contentType == "" || buf.Len() == 0 {
+		msg := "image is empty"
+		h.logger.Error().Msg(msg)
+		return status.Error(codes.InvalidArgument, msg)
+	}
+
+	data, err := stripImageMetadata(buf.Bytes(), contentType)
+	if err != nil {
+		msg := "invalid image"
+		h.logger.Error().Err(err).Msg(msg)
+		return status.Error(codes.InvalidArgument, msg)
+	}
+
+	key := uuid.New().String() + imageExtensions[contentType]
+	if err := h.bs.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		msg := "failed to store image"
+		h.logger.Error().Err(err).Msg(msg)
+		return status.Error(codes.Aborted, "internal server error")
+	}
+
+	h.logger.Info().Uint("user_id", userID).Str("key", key).Msg("stored uploaded image")
+
+	return stream.SendAndClose(&pb.UploadImageResponse{Url: h.imageURL(key)})
+}
+
+// GetImage returns an uploaded image
+func (h *Handler) GetImage(ctx context.Context, req *pb.GetImageRequest) (*httpbody.HttpBody, error) {
+	h = h.withContext(ctx)
+
+	contentType := ""
+	for t, ext := range imageExtensions {
+		if path.Ext(req.GetName()) == ext {
+			contentType = t
+		}
+	}
+	if contentType == "" {
+		return nil, status.Error(codes.NotFound, "image not found")
+	}
+
+	r, err := h.bs.Get(ctx, req.GetName())
+	if errors.Is(err, blob.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "image not found")
+	}
+	if err != nil {
+		msg := "failed to open image"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		msg := "failed to read image"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return &httpbody.HttpBody{ContentType: contentType, Data: data}, nil
+}
+
+// imageURL returns the public URL of the uploaded image
+func (h *Handler) imageURL(key string) string {
+	return strings.TrimSuffix(h.cfg.ImageBaseURL, "/") + "/images/" + key
+}
+
+// stripImageMetadata re-encodes the image to drop metadata such as EXIF
+func stripImageMetadata(data []byte, contentType string) ([]byte, error) {
+	if detected := http.DetectContentType(data); detected != contentType {
+		return nil, fmt.Errorf("content type mismatch: declared %s, detected %s", contentType, detected)
+	}
+
+	// check dimensions before decoding the image
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	frames := 1
+	if contentType == "image/gif" {
+		frames, err = countGIFFrames(data)
+		if err != nil {
+			return nil, err
+		}
+		if frames > MaxGIFFrames {
+			return nil, fmt.Errorf("too many frames: %d", frames)
+		}
+	}
+	if int64(cfg.Width)*int64(cfg.Height)*int64(frames) > MaxImagePixels {
+		return nil, fmt.Errorf("image too large: %dx%d with %d frames", cfg.Width, cfg.Height, frames)
+	}
+
+	var buf bytes.Buffer
+	switch contentType {
+	case "image/jpeg":
+		img, err := jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+		if err != nil {
+			return nil, err
+		}
+	case "image/png":
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+	case "image/gif":
+		g, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if err := gif.EncodeAll(&buf, g); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, image.ErrFormat
+	}
+
+	return buf.Bytes(), nil
+}
+
+// countGIFFrames counts the frames of a GIF by walking its blocks without
+// decompressing them
+func countGIFFrames(data []byte) (int, error) {
+	errMalformed := errors.New("gif: malformed data")
+
+	// header and logical screen descriptor
+	if len(data) < 13 {
+		return 0, errMalformed
+	}
+	i := 13
+	if data[10]&0x80 != 0 {
+		i += 3 << (data[10]&0x07 + 1)
+	}
+
+	// skipSubBlocks returns the position after the sub-blocks starting at j
+	skipSubBlocks := func(j int) (int, error) {
+		for {
+			if j >= len(data) {
+				return 0, errMalformed
+			}
+			n := int(data[j])
+			j++
+			if n == 0 {
+				return j, nil
+			}
+			j += n
+		}
+	}
+
+	frames := 0
+	for {
+		if i >= len(data) {
+			return 0, errMalformed
+		}
+		switch data[i] {
+		case 0x21: // extension
+			j, err := skipSubBlocks(i + 2)
+			if err != nil {
+				return 0, err
+			}
+			i = j
+		case 0x2c: // image descriptor
+			if i+10 > len(data) {
+				return 0, errMalformed
+			}
+			flags := data[i+9]
+			i += 10
+			if flags&0x80 != 0 {
+				i += 3 << (flags&0x07 + 1)
+			}
+			// LZW minimum code size, then the image data
+			j, err := skipSubBlocks(i + 1)
+			if err != nil {
+				return 0, err
+			}
+			i = j
+			frames++
+			if frames > MaxGIFFrames {
+				return frames, nil
+			}
+		case 0x3b: // trailer
+			return frames, nil
+		default:
+			return 0, errMalformed
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"path"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// uploadStream is a fake client stream of UploadImage
+type uploadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*pb.UploadImageRequest
+	resp *pb.UploadImageResponse
+}
+
+func (s *uploadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadStream) Recv() (*pb.UploadImageRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *uploadStream) SendAndClose(resp *pb.UploadImageResponse) error {
+	s.resp = resp
+	return nil
+}
+
+// chunks splits data into upload requests
+func chunks(contentType string, data []byte, size int) []*pb.UploadImageRequest {
+	var reqs []*pb.UploadImageRequest
+	for len(data) > 0 {
+		n := size
+		if len(data) < n {
+			n = len(data)
+		}
+		reqs = append(reqs, &pb.UploadImageRequest{ContentType: contentType, Chunk: data[:n]})
+		data = data[n:]
+	}
+	return reqs
+}
+
+// pngWithText returns a PNG image including a tEXt metadata chunk
+func pngWithText(t *testing.T, text string) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	// insert the chunk right after the signature (8 bytes) and IHDR (25 bytes)
+	body := append([]byte("tEXt"), []byte("Comment\x00"+text)...)
+	chunk := make([]byte, 4, len(body)+8)
+	binary.BigEndian.PutUint32(chunk, uint32(len(body)-4))
+	chunk = append(chunk, body...)
+	chunk = append(chunk, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(chunk[len(chunk)-4:], crc32.ChecksumIEEE(body))
+
+	out := append([]byte{}, data[:33]...)
+	out = append(out, chunk...)
+	return append(out, data[33:]...)
+}
+
+func TestUploadImage(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+	if err := h.us.Create(&fooUser); err != nil {
+		t.Fatalf("failed to create initial user record: %v", err)
+	}
+
+	token, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := ctxWithToken(context.Background(), token)
+
+	valid := pngWithText(t, "secret-location")
+	if _, err := png.Decode(bytes.NewReader(valid)); err != nil {
+		t.Fatalf("failed to build test image: %v", err)
+	}
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		reqs     []*pb.UploadImageRequest
+		hasError bool
+	}{
+		{
+			"upload image: success",
+			ctx,
+			chunks("image/png", valid, 16),
+			false,
+		},
+		{
+			"upload image: unauthenticated",
+			context.Background(),
+			chunks("image/png", valid, 16),
+			true,
+		},
+		{
+			"upload image: unsupported content type",
+			ctx,
+			chunks("image/svg+xml", []byte("<svg></svg>"), 16),
+			true,
+		},
+		{
+			"upload image: content does not match type",
+			ctx,
+			chunks("image/jpeg", valid, 16),
+			true,
+		},
+		{
+			"upload image: too large",
+			ctx,
+			chunks("image/png", make([]byte, MaxImageSize+1), 1<<20),
+			true,
+		},
+		{
+			"upload image: empty",
+			ctx,
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		stream := &uploadStream{ctx: tt.ctx, reqs: tt.reqs}
+		err := h.UploadImage(stream)
+		if tt.hasError {
+			if err == nil {
+				t.Errorf("%q expected to fail, but succeeded.", tt.title)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q expected to succeed, but failed. %v", tt.title, err)
+			continue
+		}
+
+		url := stream.resp.GetUrl()
+		assert.Contains(t, url, "/images/")
+		assert.Equal(t, ".png", path.Ext(url))
+
+		got, err := h.GetImage(context.Background(), &pb.GetImageRequest{Name: path.Base(url)})
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, "image/png", got.GetContentType())
+		assert.NotContains(t, string(got.GetData()), "secret-location", "metadata should be stripped")
+		_, err = png.Decode(bytes.NewReader(got.GetData()))
+		assert.NoError(t, err)
+	}
+
+	_, err = h.GetImage(context.Background(), &pb.GetImageRequest{Name: "unknown.png"})
+	assert.Error(t, err)
+
+	_, err = h.GetImage(context.Background(), &pb.GetImageRequest{Name: "../secret.png"})
+	assert.Error(t, err)
+}
+
+func TestStripImageMetadataLimits(t *testing.T) {
+	// a PNG declaring huge dimensions in IHDR
+	hugePNG := pngWithText(t, "")
+	binary.BigEndian.PutUint32(hugePNG[16:], 100000)
+	binary.BigEndian.PutUint32(hugePNG[20:], 100000)
+	binary.BigEndian.PutUint32(hugePNG[29:], crc32.ChecksumIEEE(hugePNG[12:29]))
+
+	animation := func(frames int) []byte {
+		g := &gif.GIF{}
+		for i := 0; i < frames; i++ {
+			g.Image = append(g.Image, image.NewPaletted(image.Rect(0, 0, 2, 2), color.Palette{color.Black, color.White}))
+			g.Delay = append(g.Delay, 0)
+		}
+		var buf bytes.Buffer
+		if err := gif.EncodeAll(&buf, g); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	for _, tt := range []struct {
+		title       string
+		contentType string
+		data        []byte
+		hasError    bool
+	}{
+		{"small png", "image/png", pngWithText(t, ""), false},
+		{"huge png", "image/png", hugePNG, true},
+		{"animated gif", "image/gif", animation(3), false},
+		{"too many frames", "image/gif", animation(MaxGIFFrames + 1), true},
+	} {
+		_, err := stripImageMetadata(tt.data, tt.contentType)
+		assert.Equal(t, tt.hasError, err != nil, "%s: %v", tt.title, err)
+	}
+
+	n, err := countGIFFrames(animation(3))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
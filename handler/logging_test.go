@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/logging"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLoggingInterceptor(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+	if err := fooUser.HashPassword(); err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	if err := h.us.Create(&fooUser); err != nil {
+		t.Fatalf("failed to create initial user record: %v", err)
+	}
+
+	for _, tt := range []struct {
+		title     string
+		password  string
+		requestID string
+		logs      int
+	}{
+		{"login", "secret", "req-1", 1},
+		{"wrong password: logged by the handler too", "hunter2", "req-2", 2},
+	} {
+		var buf bytes.Buffer
+		l := zerolog.New(&buf)
+		interceptor := logging.UnaryServerInterceptor(&l)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(logging.RequestIDKey, tt.requestID))
+		req := &pb.LoginUserRequest{User: &pb.LoginUserRequest_User{Email: "foo@example.com", Password: tt.password}}
+		info := &grpc.UnaryServerInfo{FullMethod: "/user.Users/LoginUser"}
+		interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return h.LoginUser(ctx, req.(*pb.LoginUserRequest))
+		})
+
+		assert.NotContains(t, buf.String(), tt.password, tt.title)
+
+		// logs of the handler are correlated with the request
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.GreaterOrEqual(t, len(lines), tt.logs, tt.title)
+		for _, line := range lines {
+			var entry map[string]interface{}
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				t.Fatalf("%q: failed to parse log: %v", tt.title, err)
+			}
+			assert.Equal(t, tt.requestID, entry["request_id"], tt.title)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/raahii/golang-grpc-realworld-example/metrics"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusinessMetrics(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	counters := map[string]prometheus.Counter{
+		"signups":  metrics.Signups,
+		"articles": metrics.ArticlesCreated,
+		"comments": metrics.CommentsCreated,
+	}
+	before := map[string]float64{}
+	for name, c := range counters {
+		before[name] = testutil.ToFloat64(c)
+	}
+
+	user, err := h.CreateUser(context.Background(), &pb.CreateUserRequest{
+		User: &pb.CreateUserRequest_User{
+			Username: "foo",
+			Email:    "foo@example.com",
+			Password: "secret",
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	ctx := ctxWithToken(context.Background(), user.GetUser().GetToken())
+
+	article, err := h.CreateArticle(ctx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"go"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+
+	if _, err := h.CreateComment(ctx, &pb.CreateCommentRequest{
+		Slug:    article.GetArticle().GetSlug(),
+		Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+	}); err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	// failures are not counted
+	h.CreateComment(ctx, &pb.CreateCommentRequest{
+		Slug:    "0",
+		Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+	})
+
+	for name, c := range counters {
+		assert.Equal(t, before[name]+1, testutil.ToFloat64(c), name)
+	}
+}
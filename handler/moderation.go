@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reportedContent is an article or a comment reported by users
+type reportedContent struct {
+	article *model.Article
+	comment *model.Comment
+	author  model.User
+	body    string
+	hidden  bool
+}
+
+// ReportContent reports an abusive article or comment to staff
+func (h *Handler) ReportContent(ctx context.Context, req *pb.ReportContentRequest) (*pb.ReportResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetID, err := strconv.Atoi(req.GetTargetId())
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert target id (%s) into integer", req.GetTargetId())
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid target id")
+	}
+
+	r := model.Report{
+		TargetType: req.GetTargetType(),
+		TargetID:   uint(targetID),
+		ReporterID: currentUser.ID,
+		Reason:     req.GetReason(),
+	}
+
+	if err := r.Validate(); err != nil {
+		msg := "validation error"
+		err = fmt.Errorf("validation error: %w", err)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	content, err := h.getReportedContent(r.TargetType, r.TargetID)
+	if err != nil || content.hidden || !content.article.VisibleTo(currentUser) {
+		msg := fmt.Sprintf("requested %s (id=%d) not found", r.TargetType, r.TargetID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "content not found")
+	}
+
+	if content.author.ID == currentUser.ID {
+		msg := "cannot report your own content"
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, msg)
+	}
+
+	reported, err := h.rs.HasReported(r.TargetType, r.TargetID, currentUser)
+	if err != nil {
+		msg := "failed to get reported status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	if reported {
+		msg := "already reported"
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.AlreadyExists, msg)
+	}
+
+	hidden, err := h.rs.Create(&r, h.cfg.AutoHideReports)
+	if err != nil {
+		msg := "failed to create report"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	if hidden {
+		h.logger.Info().Str("target_type", r.TargetType).Uint("target_id", r.TargetID).
+			Msg("reported content is hidden until reviewed")
+	}
+
+	r.Reporter = *currentUser
+
+	return &pb.ReportResponse{Report: r.ProtoReport()}, nil
+}
+
+// ListModerationQueue lists reported contents which staff have not resolved yet
+func (h *Handler) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ModerationQueueResponse, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	ts, err := h.rs.GetQueue(limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get moderation queue"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	items := make([]*pb.ModerationItem, 0, len(ts))
+	for _, t := range ts {
+		item := pb.ModerationItem{
+			TargetType: t.TargetType,
+			TargetId:   fmt.Sprintf("%d", t.TargetID),
+		}
+
+		// the content may have been deleted after reported
+		content, err := h.getReportedContent(t.TargetType, t.TargetID)
+		if err == nil {
+			item.Slug = fmt.Sprintf("%d", content.article.ID)
+			item.Author = content.author.ProtoProfile(false)
+			item.Body = content.body
+			item.Hidden = content.hidden
+		}
+
+		reporters := map[uint]bool{}
+		for _, r := range t.Reports {
+			reporters[r.ReporterID] = true
+			item.Reports = append(item.Reports, r.ProtoReport())
+		}
+		item.ReportsCount = int32(len(reporters))
+
+		items = append(items, &item)
+	}
+
+	return &pb.ModerationQueueResponse{Items: items}, nil
+}
+
+// ResolveModerationItem resolves reports on the content by dismissing them,
+// hiding the content or suspending its author
+func (h *Handler) ResolveModerationItem(ctx context.Context, req *pb.ResolveModerationItemRequest) (*pb.Empty, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.IsModerationAction(req.GetAction()) {
+		msg := fmt.Sprintf("unknown moderation action: %s", req.GetAction())
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid action")
+	}
+
+	if t := req.GetTargetType(); t != model.ReportTargetArticle && t != model.ReportTargetComment {
+		msg := fmt.Sprintf("unknown report target type: %s", t)
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid target type")
+	}
+
+	targetID, err := strconv.Atoi(req.GetTargetId())
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert target id (%s) into integer", req.GetTargetId())
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid target id")
+	}
+
+	// reports on deleted contents can be resolved except suspending their authors
+	var authorID uint
+	content, err := h.getReportedContent(req.GetTargetType(), uint(targetID))
+	if err == nil {
+		authorID = content.author.ID
+	} else if req.GetAction() == model.ModerationSuspend {
+		msg := fmt.Sprintf("requested %s (id=%d) not found", req.GetTargetType(), targetID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "content not found")
+	}
+
+	held, err := h.rs.Resolve(req.GetTargetType(), uint(targetID), authorID, req.GetAction(), currentUser, time.Now())
+	if gorm.IsRecordNotFoundError(err) {
+		msg := fmt.Sprintf("no open reports on %s (id=%d)", req.GetTargetType(), targetID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "no open reports on the content")
+	}
+	if err != nil {
+		msg := "failed to resolve reports"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	// content held by the content filter is announced once staff approve it
+	if held && content != nil && req.GetAction() == model.ModerationDismiss {
+		h.announceApprovedContent(content)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// announceApprovedContent announces the content held by the content filter
+// as if it were saved without being held
+func (h *Handler) announceApprovedContent(content *reportedContent) {
+	if c := content.comment; c != nil {
+		pc := c.ProtoComment()
+		pc.Author = c.Author.ProtoProfile(false)
+
+		h.notify(model.NewCommentNotification(&c.Author, content.article, c))
+		h.publishComment(commentCreated, c)
+		h.triggerArticleWebhooks(model.EventCommentCreated, content.article, map[string]proto.Message{
+			"comment": pc,
+		})
+		return
+	}
+
+	// articles are held on creation and on updates, which are told apart by revisions
+	a := content.article
+	rs, err := h.as.GetRevisions(a, 2, 0)
+	if err != nil {
+		h.logger.Error().Err(err).Uint("article_id", a.ID).Msg("failed to get revisions")
+		return
+	}
+	if len(rs) > 1 {
+		h.triggerArticleWebhooks(model.EventArticleUpdated, a, nil)
+		return
+	}
+	if a.IsPublished() {
+		h.publishArticle(a)
+	}
+	h.triggerArticleWebhooks(model.EventArticleCreated, a, nil)
+}
+
+// getReportedContent finds the reported article or comment
+func (h *Handler) getReportedContent(targetType string, id uint) (*reportedContent, error) {
+	switch targetType {
+	case model.ReportTargetArticle:
+		a, err := h.as.GetByID(id)
+		if err != nil {
+			return nil, err
+		}
+		return &reportedContent{
+			article: a,
+			author:  a.Author,
+			body:    a.Body,
+			hidden:  a.IsHidden(),
+		}, nil
+	case model.ReportTargetComment:
+		c, err := h.as.GetCommentByID(id)
+		if err != nil {
+			return nil, err
+		}
+		a, err := h.as.GetByID(c.ArticleID)
+		if err != nil {
+			return nil, err
+		}
+		return &reportedContent{
+			article: a,
+			comment: c,
+			author:  c.Author,
+			body:    c.Body,
+			hidden:  c.IsHidden(),
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown report target type: %s", targetType)
+}
@@ -0,0 +1,306 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModeration(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	h.cfg.AutoHideReports = 2
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+	if err := fooUser.HashPassword(); err != nil {
+		t.Fatal(err)
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	bazUser := model.User{
+		Username: "baz",
+		Email:    "baz@example.com",
+		Password: "secret",
+	}
+
+	adminUser := model.User{
+		Username: "admin",
+		Email:    "admin@example.com",
+		Password: "secret",
+		Admin:    true,
+	}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser, &bazUser, &adminUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	articleID, err := strconv.Atoi(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	comment, err := h.CreateComment(ctxs["foo"], &pb.CreateCommentRequest{
+		Slug:    slug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+	commentID := comment.GetComment().GetId()
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		req      *pb.ReportContentRequest
+		hidden   bool
+		hasError bool
+	}{
+		{
+			"report comment: success",
+			ctxs["bar"],
+			&pb.ReportContentRequest{TargetType: model.ReportTargetComment, TargetId: commentID, Reason: "rude"},
+			false,
+			false,
+		},
+		{
+			"report article: success",
+			ctxs["bar"],
+			&pb.ReportContentRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Reason: "spam"},
+			false,
+			false,
+		},
+		{
+			"report article twice: already reported",
+			ctxs["bar"],
+			&pb.ReportContentRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Reason: "spam"},
+			false,
+			true,
+		},
+		{
+			"report own article: forbidden",
+			ctxs["foo"],
+			&pb.ReportContentRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Reason: "spam"},
+			false,
+			true,
+		},
+		{
+			"report article without reason: validation error",
+			ctxs["baz"],
+			&pb.ReportContentRequest{TargetType: model.ReportTargetArticle, TargetId: slug},
+			false,
+			true,
+		},
+		{
+			"report unknown content: validation error",
+			ctxs["baz"],
+			&pb.ReportContentRequest{TargetType: "user", TargetId: slug, Reason: "spam"},
+			false,
+			true,
+		},
+		{
+			"report article: not found",
+			ctxs["baz"],
+			&pb.ReportContentRequest{TargetType: model.ReportTargetArticle, TargetId: "0", Reason: "spam"},
+			false,
+			true,
+		},
+		{
+			"report article: unauthenticated",
+			context.Background(),
+			&pb.ReportContentRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Reason: "spam"},
+			false,
+			true,
+		},
+		{
+			"report article by another user: hidden automatically",
+			ctxs["baz"],
+			&pb.ReportContentRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Reason: "abusive"},
+			true,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		resp, err := h.ReportContent(tt.ctx, tt.req)
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if !assert.NoError(t, err, tt.title) {
+			continue
+		}
+		assert.Equal(t, tt.req.GetReason(), resp.GetReport().GetReason(), tt.title)
+
+		article, err := h.as.GetByID(uint(articleID))
+		if assert.NoError(t, err, tt.title) {
+			assert.Equal(t, tt.hidden, article.IsHidden(), tt.title)
+		}
+	}
+
+	// hidden article is filtered out for everyone but the author
+	articles, err := h.GetArticles(ctxs["bar"], &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, articles.GetArticles())
+	}
+
+	tags, err := h.GetTags(context.Background(), &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, tags.GetTags())
+	}
+
+	_, err = h.GetArticle(ctxs["bar"], &pb.GetArticleRequest{Slug: slug})
+	assert.Error(t, err)
+
+	_, err = h.GetArticle(context.Background(), &pb.GetArticleRequest{Slug: slug})
+	assert.Error(t, err, "anonymous user")
+
+	_, err = h.GetArticle(ctxs["foo"], &pb.GetArticleRequest{Slug: slug})
+	assert.NoError(t, err)
+
+	// only staff can see the queue
+	_, err = h.ListModerationQueue(ctxs["bar"], &pb.ListModerationQueueRequest{})
+	assert.Error(t, err)
+
+	queue, err := h.ListModerationQueue(ctxs["admin"], &pb.ListModerationQueueRequest{})
+	if assert.NoError(t, err) && assert.Len(t, queue.GetItems(), 2) {
+		// earliest reported first
+		item := queue.GetItems()[0]
+		assert.Equal(t, model.ReportTargetComment, item.GetTargetType())
+		assert.Equal(t, commentID, item.GetTargetId())
+		assert.Equal(t, slug, item.GetSlug())
+		assert.False(t, item.GetHidden())
+		assert.Equal(t, int32(1), item.GetReportsCount())
+
+		item = queue.GetItems()[1]
+		assert.Equal(t, model.ReportTargetArticle, item.GetTargetType())
+		assert.Equal(t, slug, item.GetTargetId())
+		assert.Equal(t, "foo", item.GetAuthor().GetUsername())
+		assert.True(t, item.GetHidden())
+		assert.Equal(t, int32(2), item.GetReportsCount())
+		assert.Len(t, item.GetReports(), 2)
+	}
+
+	resolves := []struct {
+		title    string
+		ctx      context.Context
+		req      *pb.ResolveModerationItemRequest
+		hasError bool
+	}{
+		{
+			"resolve by non-staff: forbidden",
+			ctxs["bar"],
+			&pb.ResolveModerationItemRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Action: model.ModerationDismiss},
+			true,
+		},
+		{
+			"resolve with unknown action: invalid argument",
+			ctxs["admin"],
+			&pb.ResolveModerationItemRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Action: "delete"},
+			true,
+		},
+		{
+			"dismiss reports on article: success",
+			ctxs["admin"],
+			&pb.ResolveModerationItemRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Action: model.ModerationDismiss},
+			false,
+		},
+		{
+			"dismiss resolved reports: not found",
+			ctxs["admin"],
+			&pb.ResolveModerationItemRequest{TargetType: model.ReportTargetArticle, TargetId: slug, Action: model.ModerationDismiss},
+			true,
+		},
+	}
+
+	for _, tt := range resolves {
+		_, err := h.ResolveModerationItem(tt.ctx, tt.req)
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+		} else {
+			assert.NoError(t, err, tt.title)
+		}
+	}
+
+	// dismissed article is public again
+	articles, err = h.GetArticles(ctxs["bar"], &pb.GetArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{slug}, articleSlugs(articles.GetArticles()))
+	}
+
+	queue, err = h.ListModerationQueue(ctxs["admin"], &pb.ListModerationQueueRequest{})
+	if assert.NoError(t, err) && assert.Len(t, queue.GetItems(), 1) {
+		assert.Equal(t, commentID, queue.GetItems()[0].GetTargetId())
+	}
+
+	// suspend the author of the comment
+	_, err = h.ResolveModerationItem(ctxs["admin"], &pb.ResolveModerationItemRequest{
+		TargetType: model.ReportTargetComment,
+		TargetId:   commentID,
+		Action:     model.ModerationSuspend,
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	comments, err := h.GetComments(ctxs["bar"], &pb.GetCommentsRequest{Slug: slug})
+	if assert.NoError(t, err) {
+		assert.Empty(t, comments.GetComments())
+	}
+
+	queue, err = h.ListModerationQueue(ctxs["admin"], &pb.ListModerationQueueRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, queue.GetItems())
+	}
+
+	_, err = h.AuthFunc(ctxs["foo"])
+	assert.Error(t, err, "suspended user")
+
+	_, err = h.AuthFunc(ctxs["bar"])
+	assert.NoError(t, err)
+
+	_, err = h.AuthFunc(context.Background())
+	assert.NoError(t, err, "anonymous user")
+
+	_, err = h.LoginUser(context.Background(), &pb.LoginUserRequest{
+		User: &pb.LoginUserRequest_User{Email: "foo@example.com", Password: "secret"},
+	})
+	assert.Error(t, err, "suspended user cannot login")
+}
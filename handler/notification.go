@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListNotifications gets notifications of current user
+func (h *Handler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.NotificationsResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	ns, err := h.ns.GetNotifications(currentUser, req.GetUnreadOnly(), limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get notifications"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pns := make([]*pb.Notification, 0, len(ns))
+	for _, n := range ns {
+		pn, err := h.protoNotification(currentUser, &n)
+		if err != nil {
+			return nil, err
+		}
+		pns = append(pns, pn)
+	}
+
+	return &pb.NotificationsResponse{Notifications: pns, NotificationsCount: int32(len(pns))}, nil
+}
+
+// GetUnreadCount gets the number of unread notifications of current user
+func (h *Handler) GetUnreadCount(ctx context.Context, req *pb.Empty) (*pb.UnreadCountResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := h.ns.CountUnread(currentUser)
+	if err != nil {
+		msg := "failed to count unread notifications"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return &pb.UnreadCountResponse{Count: int32(count)}, nil
+}
+
+// MarkNotificationRead marks a notification of current user as read
+func (h *Handler) MarkNotificationRead(ctx context.Context, req *pb.MarkNotificationReadRequest) (*pb.NotificationResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.Atoi(req.GetId())
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert id (%s) into integer", req.GetId())
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid notification id")
+	}
+
+	n, err := h.ns.GetByID(currentUser, uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("requested notification (id=%d) not found", id)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "notification not found")
+	}
+
+	if err := h.ns.MarkRead(n, time.Now()); err != nil {
+		msg := "failed to mark notification read"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pn, err := h.protoNotification(currentUser, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NotificationResponse{Notification: pn}, nil
+}
+
+// MarkAllNotificationsRead marks all notifications of current user as read
+func (h *Handler) MarkAllNotificationsRead(ctx context.Context, req *pb.Empty) (*pb.UnreadCountResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := h.ns.MarkAllRead(currentUser, time.Now())
+	if err != nil {
+		msg := "failed to mark notifications read"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	h.logger.Info().Int64("count", n).Msg("marked notifications read")
+
+	return &pb.UnreadCountResponse{Count: 0}, nil
+}
+
+func (h *Handler) protoNotification(currentUser *model.User, n *model.Notification) (*pb.Notification, error) {
+	following, err := h.us.IsFollowing(currentUser, &n.Actor)
+	if err != nil {
+		msg := "failed to get following status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pn := n.ProtoNotification()
+	pn.Actor = n.Actor.ProtoProfile(following)
+	return pn, nil
+}
+
+// notify records a notification. Failures are only logged
+// not to fail the action which caused the notification.
+func (h *Handler) notify(n *model.Notification) {
+	if n.UserID == n.ActorID {
+		return
+	}
+
+	if err := h.ns.Create(n); err != nil {
+		h.logger.Error().Err(err).
+			Uint("user_id", n.UserID).
+			Str("type", n.Type).
+			Msg("failed to create notification")
+	}
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifications(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	// actions of bar notify foo
+	if _, err := h.FollowUser(barCtx, &pb.FollowRequest{Username: "foo"}); err != nil {
+		t.Fatalf("failed to follow user: %v", err)
+	}
+	comment, err := h.CreateComment(barCtx, &pb.CreateCommentRequest{
+		Slug:    slug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "nice"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+	if _, err := h.FavoriteArticle(barCtx, &pb.FavoriteArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+
+	// actions on own contents notify nobody
+	_, err = h.CreateComment(fooCtx, &pb.CreateCommentRequest{
+		Slug:    slug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "thanks"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	_, err = h.ListNotifications(context.Background(), &pb.ListNotificationsRequest{})
+	assert.Error(t, err, "unauthenticated user has no notifications")
+
+	list, err := h.ListNotifications(fooCtx, &pb.ListNotificationsRequest{})
+	if err != nil {
+		t.Fatalf("failed to list notifications: %v", err)
+	}
+	ns := list.GetNotifications()
+	if !assert.Len(t, ns, 3) {
+		t.FailNow()
+	}
+	assert.Equal(t, model.NotificationFavorite, ns[0].GetType())
+	assert.Equal(t, slug, ns[0].GetSlug())
+	assert.Equal(t, model.NotificationComment, ns[1].GetType())
+	assert.Equal(t, comment.GetComment().GetId(), ns[1].GetCommentId())
+	assert.Equal(t, model.NotificationFollow, ns[2].GetType())
+	for _, n := range ns {
+		assert.Equal(t, "bar", n.GetActor().GetUsername())
+		assert.False(t, n.GetRead())
+	}
+
+	list, err = h.ListNotifications(barCtx, &pb.ListNotificationsRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, list.GetNotifications())
+	}
+
+	paged, err := h.ListNotifications(fooCtx, &pb.ListNotificationsRequest{Limit: 1, Offset: 1})
+	if assert.NoError(t, err) && assert.Len(t, paged.GetNotifications(), 1) {
+		assert.Equal(t, ns[1].GetId(), paged.GetNotifications()[0].GetId())
+	}
+
+	unread, err := h.GetUnreadCount(fooCtx, &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(3), unread.GetCount())
+	}
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		req      *pb.MarkNotificationReadRequest
+		hasError bool
+	}{
+		{
+			"mark notification read: other user's notification",
+			barCtx,
+			&pb.MarkNotificationReadRequest{Id: ns[2].GetId()},
+			true,
+		},
+		{
+			"mark notification read: invalid id",
+			fooCtx,
+			&pb.MarkNotificationReadRequest{Id: "foo"},
+			true,
+		},
+		{
+			"mark notification read: success",
+			fooCtx,
+			&pb.MarkNotificationReadRequest{Id: ns[2].GetId()},
+			false,
+		},
+		{
+			"mark notification read: already read",
+			fooCtx,
+			&pb.MarkNotificationReadRequest{Id: ns[2].GetId()},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		resp, err := h.MarkNotificationRead(tt.ctx, tt.req)
+		if tt.hasError {
+			if err == nil {
+				t.Errorf("%q expected to fail, but succeeded.", tt.title)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q expected to succeed, but failed. %v", tt.title, err)
+			continue
+		}
+
+		assert.True(t, resp.GetNotification().GetRead())
+	}
+
+	unread, err = h.GetUnreadCount(fooCtx, &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(2), unread.GetCount())
+	}
+
+	list, err = h.ListNotifications(fooCtx, &pb.ListNotificationsRequest{UnreadOnly: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.GetNotifications(), 2)
+	}
+
+	if _, err := h.MarkAllNotificationsRead(fooCtx, &pb.Empty{}); err != nil {
+		t.Fatalf("failed to mark all notifications read: %v", err)
+	}
+
+	unread, err = h.GetUnreadCount(fooCtx, &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(0), unread.GetCount())
+	}
+}
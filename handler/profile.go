@@ -3,8 +3,10 @@ package handler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
 	pb "github.com/raahii/golang-grpc-realworld-example/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,7 +14,7 @@ import (
 
 // ShowProfile gets a profile
 func (h *Handler) ShowProfile(ctx context.Context, req *pb.ShowProfileRequest) (*pb.ProfileResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("show profile")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -33,6 +35,19 @@ func (h *Handler) ShowProfile(ctx context.Context, req *pb.ShowProfileRequest) (
 		return nil, status.Error(codes.NotFound, msg)
 	}
 
+	// users blocking current user look like they don't exist
+	blocked, err := h.us.IsBlocking(requestUser, currentUser)
+	if err != nil {
+		msg := "failed to get blocked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	if blocked {
+		msg := fmt.Sprintf("user(id=%d) is blocked by user(id=%d)", currentUser.ID, requestUser.ID)
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.NotFound, "user was not found")
+	}
+
 	following, err := h.us.IsFollowing(currentUser, requestUser)
 	if err != nil {
 		msg := "failed to get following status"
@@ -45,7 +60,7 @@ func (h *Handler) ShowProfile(ctx context.Context, req *pb.ShowProfileRequest) (
 
 // FollowUser follow a user
 func (h *Handler) FollowUser(ctx context.Context, req *pb.FollowRequest) (*pb.ProfileResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("follow user")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -71,6 +86,19 @@ func (h *Handler) FollowUser(ctx context.Context, req *pb.FollowRequest) (*pb.Pr
 		return nil, status.Error(codes.NotFound, "user was not found")
 	}
 
+	blocked, err := h.us.IsBlockedBetween(currentUser, requestUser)
+	if err != nil {
+		msg := "failed to get blocked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	if blocked {
+		msg := fmt.Sprintf("user(id=%d) attempted to follow blocking or blocked user(id=%d)",
+			currentUser.ID, requestUser.ID)
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.PermissionDenied, "cannot follow the user")
+	}
+
 	err = h.us.Follow(currentUser, requestUser)
 	if err != nil {
 		msg := fmt.Sprintf("failed to follow user: (ID: %d) -> (ID: %d)",
@@ -79,12 +107,14 @@ func (h *Handler) FollowUser(ctx context.Context, req *pb.FollowRequest) (*pb.Pr
 		return nil, status.Error(codes.Aborted, "failed to follow user")
 	}
 
+	h.notify(model.NewFollowNotification(currentUser, requestUser))
+
 	return &pb.ProfileResponse{Profile: requestUser.ProtoProfile(true)}, nil
 }
 
 // UnfollowUser unfollow a user
 func (h *Handler) UnfollowUser(ctx context.Context, req *pb.UnfollowRequest) (*pb.ProfileResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("unfollow user")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -133,3 +163,96 @@ func (h *Handler) UnfollowUser(ctx context.Context, req *pb.UnfollowRequest) (*p
 
 	return &pb.ProfileResponse{Profile: requestUser.ProtoProfile(false)}, nil
 }
+
+// ListFollowers lists users following a user
+func (h *Handler) ListFollowers(ctx context.Context, req *pb.ListFollowersRequest) (*pb.ProfilesResponse, error) {
+	h = h.withContext(ctx)
+	return h.listFollows(ctx, req.GetUsername(), req.GetLimit(), req.GetOffset(), h.us.GetFollowers)
+}
+
+// ListFollowing lists users a user follows
+func (h *Handler) ListFollowing(ctx context.Context, req *pb.ListFollowingRequest) (*pb.ProfilesResponse, error) {
+	h = h.withContext(ctx)
+	return h.listFollows(ctx, req.GetUsername(), req.GetLimit(), req.GetOffset(), h.us.GetFollowing)
+}
+
+// listFollows lists users related to the user of the username by get,
+// with following flags of current user if exists
+func (h *Handler) listFollows(ctx context.Context, username string, limit, offset int64,
+	get func(m *model.User, limit, offset int64) ([]model.User, error)) (*pb.ProfilesResponse, error) {
+	var currentUser *model.User
+	userID, err := auth.GetUserID(ctx)
+	if err == nil {
+		currentUser, err = h.us.GetByID(userID)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("current user not found")
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+	}
+
+	requestUser, err := h.us.GetByUsername(username)
+	if err != nil {
+		msg := "user was not found"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, msg)
+	}
+
+	// users blocking current user look like they don't exist
+	blocked, err := h.us.IsBlocking(requestUser, currentUser)
+	if err != nil {
+		msg := "failed to get blocked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	if blocked {
+		msg := fmt.Sprintf("user(id=%d) is blocked by user(id=%d)", currentUser.ID, requestUser.ID)
+		h.logger.Error().Msg(msg)
+		return nil, status.Error(codes.NotFound, "user was not found")
+	}
+
+	if limit == 0 {
+		limit = 20
+	}
+
+	us, err := get(requestUser, limit, offset)
+	if err != nil {
+		msg := "failed to get users"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	// get users current user follows at once rather than for each user
+	following := map[uint]bool{}
+	if currentUser != nil {
+		ids, err := h.us.GetFollowingUserIDs(currentUser)
+		if err != nil {
+			msg := "failed to get following user ids"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+		for _, id := range ids {
+			following[id] = true
+		}
+	}
+
+	pps := make([]*pb.Profile, 0, len(us))
+	for _, u := range us {
+		pps = append(pps, u.ProtoProfile(following[u.ID]))
+	}
+
+	return &pb.ProfilesResponse{Profiles: pps}, nil
+}
+
+// ReconcileProfileCounters recounts profile counters of every user to fix drift
+func (h *Handler) ReconcileProfileCounters(now time.Time) error {
+	n, err := h.us.ReconcileCounters()
+	if err != nil {
+		return fmt.Errorf("failed to reconcile profile counters: %w", err)
+	}
+
+	if n > 0 {
+		h.logger.Info().Int64("count", n).Msg("fixed drifted profile counters")
+	}
+
+	return nil
+}
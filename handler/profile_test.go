@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/raahii/golang-grpc-realworld-example/auth"
 	"github.com/raahii/golang-grpc-realworld-example/model"
@@ -270,3 +271,322 @@ func TestUnfollowUser(t *testing.T) {
 		assert.Equal(t, resp.GetProfile().GetFollowing(), tt.expected.GetFollowing())
 	}
 }
+
+func TestListFollows(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	var users []*model.User
+	for _, name := range []string{"foo", "bar", "baz", "qux"} {
+		u := model.User{
+			Username: name,
+			Email:    name + "@example.com",
+			Password: "secret",
+		}
+		if err := h.us.Create(&u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+		users = append(users, &u)
+	}
+	fooUser, barUser, bazUser, quxUser := users[0], users[1], users[2], users[3]
+
+	for _, f := range [][2]*model.User{
+		{barUser, fooUser},
+		{bazUser, fooUser},
+		{bazUser, barUser},
+		{fooUser, quxUser},
+	} {
+		if err := h.us.Follow(f[0], f[1]); err != nil {
+			t.Fatalf("failed to create initial user relationship: %v", err)
+		}
+	}
+
+	if err := h.us.Block(quxUser, bazUser); err != nil {
+		t.Fatalf("failed to create initial user relationship: %v", err)
+	}
+
+	bazToken, err := auth.GenerateToken(bazUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bazCtx := ctxWithToken(context.Background(), bazToken)
+
+	type follows func(ctx context.Context, username string, limit, offset int64) (*pb.ProfilesResponse, error)
+
+	followers := func(ctx context.Context, username string, limit, offset int64) (*pb.ProfilesResponse, error) {
+		return h.ListFollowers(ctx, &pb.ListFollowersRequest{Username: username, Limit: limit, Offset: offset})
+	}
+	following := func(ctx context.Context, username string, limit, offset int64) (*pb.ProfilesResponse, error) {
+		return h.ListFollowing(ctx, &pb.ListFollowingRequest{Username: username, Limit: limit, Offset: offset})
+	}
+
+	tests := []struct {
+		title     string
+		list      follows
+		ctx       context.Context
+		username  string
+		limit     int64
+		offset    int64
+		expected  []string
+		following []bool
+		hasError  bool
+	}{
+		{
+			"followers of foo seen by baz: success",
+			followers, bazCtx, "foo", 0, 0,
+			[]string{"bar", "baz"},
+			[]bool{true, false},
+			false,
+		},
+		{
+			"followers of foo without login: success",
+			followers, context.Background(), "foo", 0, 0,
+			[]string{"bar", "baz"},
+			[]bool{false, false},
+			false,
+		},
+		{
+			"followers of foo with paging: success",
+			followers, bazCtx, "foo", 1, 1,
+			[]string{"baz"},
+			[]bool{false},
+			false,
+		},
+		{
+			"following of baz: success",
+			following, bazCtx, "baz", 0, 0,
+			[]string{"bar", "foo"},
+			[]bool{true, true},
+			false,
+		},
+		{
+			"following of qux: no users",
+			following, context.Background(), "qux", 0, 0,
+			[]string{},
+			[]bool{},
+			false,
+		},
+		{
+			"followers of qux blocking baz: not found",
+			followers, bazCtx, "qux", 0, 0,
+			nil, nil,
+			true,
+		},
+		{
+			"followers of unknown user: not found",
+			followers, bazCtx, "nobody", 0, 0,
+			nil, nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		resp, err := tt.list(tt.ctx, tt.username, tt.limit, tt.offset)
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if !assert.NoError(t, err, tt.title) {
+			continue
+		}
+
+		flags := make([]bool, 0, len(resp.GetProfiles()))
+		for _, p := range resp.GetProfiles() {
+			flags = append(flags, p.GetFollowing())
+		}
+		assert.Equal(t, tt.expected, profileNames(resp.GetProfiles()), tt.title)
+		assert.Equal(t, tt.following, flags, tt.title)
+	}
+}
+
+func TestProfileCounters(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	// counters drifted from what they count
+	bazUser := model.User{
+		Username:       "baz",
+		Email:          "baz@example.com",
+		Password:       "secret",
+		FollowersCount: 3,
+		FollowingCount: 2,
+		ArticlesCount:  5,
+		FavoritesCount: 8,
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser, &bazUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	var slugs []string
+	for _, st := range []string{model.ArticleStatusPublished, model.ArticleStatusDraft} {
+		resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       "title",
+				Description: "description",
+				Body:        "body",
+				TagList:     []string{"foo"},
+				Status:      st,
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs = append(slugs, resp.GetArticle().GetSlug())
+	}
+	published, draft := slugs[0], slugs[1]
+
+	type counts struct {
+		followers, following, articles, favorites int32
+	}
+
+	profileCounts := func(ctx context.Context, username string) counts {
+		resp, err := h.ShowProfile(ctx, &pb.ShowProfileRequest{Username: username})
+		if err != nil {
+			t.Fatalf("failed to show profile: %v", err)
+		}
+		p := resp.GetProfile()
+		return counts{p.GetFollowersCount(), p.GetFollowingCount(), p.GetArticlesCount(), p.GetFavoritesCount()}
+	}
+
+	tests := []struct {
+		title  string
+		action func() error
+		foo    counts
+		bar    counts
+	}{
+		{
+			"drafts are not counted",
+			func() error { return nil },
+			counts{0, 0, 1, 0},
+			counts{0, 0, 0, 0},
+		},
+		{
+			"follow",
+			func() error {
+				_, err := h.FollowUser(barCtx, &pb.FollowRequest{Username: "foo"})
+				return err
+			},
+			counts{1, 0, 1, 0},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"follow twice",
+			func() error {
+				_, err := h.FollowUser(barCtx, &pb.FollowRequest{Username: "foo"})
+				return err
+			},
+			counts{1, 0, 1, 0},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"favorite",
+			func() error {
+				_, err := h.FavoriteArticle(barCtx, &pb.FavoriteArticleRequest{Slug: published})
+				return err
+			},
+			counts{1, 0, 1, 1},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"favorite twice",
+			func() error {
+				_, err := h.FavoriteArticle(barCtx, &pb.FavoriteArticleRequest{Slug: published})
+				return err
+			},
+			counts{1, 0, 1, 1},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"publish draft",
+			func() error {
+				_, err := h.PublishArticle(fooCtx, &pb.PublishArticleRequest{Slug: draft})
+				return err
+			},
+			counts{1, 0, 2, 1},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"update published article",
+			func() error {
+				_, err := h.UpdateArticle(fooCtx, &pb.UpdateArticleRequest{
+					Article: &pb.UpdateArticleRequest_Article{Slug: draft, Title: "new title"},
+				})
+				return err
+			},
+			counts{1, 0, 2, 1},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"unfavorite article not favorited",
+			func() error {
+				_, err := h.UnfavoriteArticle(barCtx, &pb.UnfavoriteArticleRequest{Slug: draft})
+				return err
+			},
+			counts{1, 0, 2, 1},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"delete favorited article",
+			func() error {
+				_, err := h.DeleteArticle(fooCtx, &pb.DeleteArticleRequest{Slug: published})
+				return err
+			},
+			counts{1, 0, 1, 0},
+			counts{0, 1, 0, 0},
+		},
+		{
+			"unfollow",
+			func() error {
+				_, err := h.UnfollowUser(barCtx, &pb.UnfollowRequest{Username: "foo"})
+				return err
+			},
+			counts{0, 0, 1, 0},
+			counts{0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		if err := tt.action(); err != nil {
+			t.Fatalf("%q failed: %v", tt.title, err)
+		}
+		assert.Equal(t, tt.foo, profileCounts(barCtx, "foo"), tt.title)
+		assert.Equal(t, tt.bar, profileCounts(fooCtx, "bar"), tt.title)
+	}
+
+	assert.Equal(t, counts{3, 2, 5, 8}, profileCounts(fooCtx, "baz"))
+
+	if err := h.ReconcileProfileCounters(time.Now()); err != nil {
+		t.Fatalf("failed to reconcile profile counters: %v", err)
+	}
+
+	assert.Equal(t, counts{0, 0, 0, 0}, profileCounts(fooCtx, "baz"))
+	assert.Equal(t, counts{0, 0, 1, 0}, profileCounts(barCtx, "foo"))
+}
@@ -0,0 +1,341 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isReactionKind returns whether the kind is an available reaction
+func (h *Handler) isReactionKind(kind string) bool {
+	for _, k := range h.cfg.ReactionKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// AddArticleReaction gives a reaction to an article
+func (h *Handler) AddArticleReaction(ctx context.Context, req *pb.AddArticleReactionRequest) (*pb.ArticleResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, article, err := h.getReactionArticle(ctx, req.GetSlug(), req.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.as.AddReaction(&model.Reaction{
+		TargetType: model.ReactionTargetArticle,
+		TargetID:   article.ID,
+		UserID:     currentUser.ID,
+		Kind:       req.GetKind(),
+	})
+	if err != nil {
+		msg := "failed to add reaction"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return h.articleResponse(article, currentUser)
+}
+
+// RemoveArticleReaction takes back a reaction to an article
+func (h *Handler) RemoveArticleReaction(ctx context.Context, req *pb.RemoveArticleReactionRequest) (*pb.ArticleResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, article, err := h.getReactionArticle(ctx, req.GetSlug(), req.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.as.DeleteReaction(model.ReactionTargetArticle, article.ID, currentUser, req.GetKind())
+	if err != nil {
+		msg := "failed to remove reaction"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return h.articleResponse(article, currentUser)
+}
+
+// ListArticleReactors lists users who gave the reaction to an article
+func (h *Handler) ListArticleReactors(ctx context.Context, req *pb.ListArticleReactorsRequest) (*pb.ProfilesResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, article, err := h.getReactionArticle(ctx, req.GetSlug(), req.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	return h.listReactors(currentUser, model.ReactionTargetArticle, article.ID, req.GetKind(), req.GetLimit(), req.GetOffset())
+}
+
+// AddCommentReaction gives a reaction to a comment
+func (h *Handler) AddCommentReaction(ctx context.Context, req *pb.AddCommentReactionRequest) (*pb.CommentResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, comment, err := h.getReactionComment(ctx, req.GetSlug(), req.GetId(), req.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.as.AddReaction(&model.Reaction{
+		TargetType: model.ReactionTargetComment,
+		TargetID:   comment.ID,
+		UserID:     currentUser.ID,
+		Kind:       req.GetKind(),
+	})
+	if err != nil {
+		msg := "failed to add reaction"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return h.commentResponse(comment, currentUser)
+}
+
+// RemoveCommentReaction takes back a reaction to a comment
+func (h *Handler) RemoveCommentReaction(ctx context.Context, req *pb.RemoveCommentReactionRequest) (*pb.CommentResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, comment, err := h.getReactionComment(ctx, req.GetSlug(), req.GetId(), req.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.as.DeleteReaction(model.ReactionTargetComment, comment.ID, currentUser, req.GetKind())
+	if err != nil {
+		msg := "failed to remove reaction"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return h.commentResponse(comment, currentUser)
+}
+
+// ListCommentReactors lists users who gave the reaction to a comment
+func (h *Handler) ListCommentReactors(ctx context.Context, req *pb.ListCommentReactorsRequest) (*pb.ProfilesResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, comment, err := h.getReactionComment(ctx, req.GetSlug(), req.GetId(), req.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	return h.listReactors(currentUser, model.ReactionTargetComment, comment.ID, req.GetKind(), req.GetLimit(), req.GetOffset())
+}
+
+// getReactionArticle returns current user and the article visible to the user
+// after validating the reaction kind
+func (h *Handler) getReactionArticle(ctx context.Context, slug, kind string) (*model.User, *model.Article, error) {
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !h.isReactionKind(kind) {
+		msg := fmt.Sprintf("unknown reaction kind: %q", kind)
+		h.logger.Error().Msg(msg)
+		return nil, nil, status.Error(codes.InvalidArgument, msg)
+	}
+
+	articleID, err := strconv.Atoi(slug)
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert slug (%s) into integer", slug)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	article, err := h.as.GetByID(uint(articleID))
+	if err != nil || !article.VisibleTo(currentUser) {
+		msg := fmt.Sprintf("requested article (slug=%d) not found", articleID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	return currentUser, article, nil
+}
+
+// getReactionComment returns current user and the comment of the article visible to the user
+// after validating the reaction kind
+func (h *Handler) getReactionComment(ctx context.Context, slug, id, kind string) (*model.User, *model.Comment, error) {
+	currentUser, article, err := h.getReactionArticle(ctx, slug, kind)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commentID, err := strconv.Atoi(id)
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert id (%s) into integer", id)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid comment id")
+	}
+
+	comment, err := h.as.GetCommentByID(uint(commentID))
+	if err != nil || comment.ArticleID != article.ID {
+		msg := fmt.Sprintf("requested comment (id=%d) not found in the article", commentID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid comment id")
+	}
+
+	return currentUser, comment, nil
+}
+
+// listReactors lists users who gave the reaction to the target
+func (h *Handler) listReactors(currentUser *model.User, targetType string, targetID uint, kind string, limit, offset int64) (*pb.ProfilesResponse, error) {
+	if limit == 0 {
+		limit = 20
+	}
+
+	us, err := h.as.GetReactors(targetType, targetID, kind, limit, offset)
+	if err != nil {
+		msg := "failed to get reactors"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pps := make([]*pb.Profile, 0, len(us))
+	for _, u := range us {
+		following, err := h.us.IsFollowing(currentUser, &u)
+		if err != nil {
+			msg := "failed to get following status"
+			h.logger.Error().Err(err).Msg(msg)
+			return nil, status.Error(codes.Aborted, "internal server error")
+		}
+		pps = append(pps, u.ProtoProfile(following))
+	}
+
+	return &pb.ProfilesResponse{Profiles: pps}, nil
+}
+
+// articleResponse returns the article seen from current user
+func (h *Handler) articleResponse(article *model.Article, currentUser *model.User) (*pb.ArticleResponse, error) {
+	// get whether the article is current user's favorite
+	favorited, err := h.as.IsFavorited(article, currentUser)
+	if err != nil {
+		msg := "failed to get favorited status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa := article.ProtoArticle(favorited)
+
+	// get whether the article is in current user's bookmarks
+	bookmarked, err := h.as.IsBookmarked(article, currentUser)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa.Bookmarked = bookmarked
+
+	if err := h.setArticleReactions([]*pb.Article{pa}, []uint{article.ID}, currentUser); err != nil {
+		return nil, err
+	}
+
+	// get whether current user follows article author
+	following, err := h.us.IsFollowing(currentUser, &article.Author)
+	if err != nil {
+		msg := "failed to get following status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa.Author = article.Author.ProtoProfile(following)
+
+	return &pb.ArticleResponse{Article: pa}, nil
+}
+
+// commentResponse returns the comment seen from current user
+func (h *Handler) commentResponse(comment *model.Comment, currentUser *model.User) (*pb.CommentResponse, error) {
+	pc := comment.ProtoComment()
+
+	if err := h.setCommentReactions([]*pb.Comment{pc}, []uint{comment.ID}, currentUser); err != nil {
+		return nil, err
+	}
+
+	// get whether current user follows comment author
+	following, err := h.us.IsFollowing(currentUser, &comment.Author)
+	if err != nil {
+		msg := "failed to get following status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pc.Author = comment.Author.ProtoProfile(following)
+
+	return &pb.CommentResponse{Comment: pc}, nil
+}
+
+// setArticleReactions fills reactions of the articles of ids with two queries.
+// The user may be nil if not logged in.
+func (h *Handler) setArticleReactions(pas []*pb.Article, ids []uint, u *model.User) error {
+	counts, mine, err := h.getReactions(model.ReactionTargetArticle, ids, u)
+	if err != nil {
+		return err
+	}
+
+	for i, pa := range pas {
+		pa.Reactions = h.protoReactionCounts(counts[ids[i]])
+		pa.MyReactions = mine[ids[i]]
+	}
+
+	return nil
+}
+
+// setCommentReactions fills reactions of the comments of ids with two queries.
+// The user may be nil if not logged in.
+func (h *Handler) setCommentReactions(pcs []*pb.Comment, ids []uint, u *model.User) error {
+	counts, mine, err := h.getReactions(model.ReactionTargetComment, ids, u)
+	if err != nil {
+		return err
+	}
+
+	for i, pc := range pcs {
+		pc.Reactions = h.protoReactionCounts(counts[ids[i]])
+		pc.MyReactions = mine[ids[i]]
+	}
+
+	return nil
+}
+
+// getReactions returns reaction counts of the targets and kinds of reactions the user gave
+func (h *Handler) getReactions(targetType string, ids []uint, u *model.User) (map[uint]map[string]int32, map[uint][]string, error) {
+	counts, err := h.as.GetReactionCounts(targetType, ids)
+	if err != nil {
+		msg := "failed to get reaction counts"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	given, err := h.as.GetUserReactions(targetType, ids, u)
+	if err != nil {
+		msg := "failed to get user reactions"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	// reactions of kinds no longer available are ignored
+	mine := make(map[uint][]string, len(given))
+	for id, kinds := range given {
+		for _, k := range kinds {
+			if h.isReactionKind(k) {
+				mine[id] = append(mine[id], k)
+			}
+		}
+	}
+
+	return counts, mine, nil
+}
+
+// protoReactionCounts generates counts of every available reaction kind
+func (h *Handler) protoReactionCounts(counts map[string]int32) []*pb.ReactionCount {
+	prs := make([]*pb.ReactionCount, 0, len(h.cfg.ReactionKinds))
+	for _, k := range h.cfg.ReactionKinds {
+		prs = append(prs, &pb.ReactionCount{Kind: k, Count: counts[k]})
+	}
+	return prs
+}
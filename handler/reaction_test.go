@@ -0,0 +1,324 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+// reactionCounts returns non zero reaction counts
+func reactionCounts(prs []*pb.ReactionCount) map[string]int32 {
+	counts := map[string]int32{}
+	for _, r := range prs {
+		if r.GetCount() > 0 {
+			counts[r.GetKind()] = r.GetCount()
+		}
+	}
+	return counts
+}
+
+func TestParseReactionKinds(t *testing.T) {
+	tests := []struct {
+		title    string
+		in       string
+		expected []string
+		hasError bool
+	}{
+		{"not configured", "", model.DefaultReactionKinds, false},
+		{"configured", "Like, heart ,like,,rocket", []string{"like", "heart", "rocket"}, false},
+		{"only blanks", " , ", model.DefaultReactionKinds, false},
+		{"too long", "like," + strings.Repeat("a", 33), nil, true},
+	}
+
+	for _, tt := range tests {
+		kinds, err := model.ParseReactionKinds(tt.in)
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+		assert.NoError(t, err, tt.title)
+		assert.Equal(t, tt.expected, kinds, tt.title)
+	}
+}
+
+func TestArticleReactions(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	ctxs := map[string]context.Context{}
+	for _, name := range []string{"foo", "bar", "baz"} {
+		u := model.User{
+			Username: name,
+			Email:    name + "@example.com",
+			Password: "secret",
+		}
+		if err := h.us.Create(&u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[name] = ctxWithToken(context.Background(), token)
+	}
+
+	resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		kind     string
+		remove   bool
+		counts   map[string]int32
+		mine     []string
+		hasError bool
+	}{
+		{
+			"bar likes: success",
+			ctxs["bar"], "like", false,
+			map[string]int32{"like": 1},
+			[]string{"like"},
+			false,
+		},
+		{
+			"bar likes twice: counted once",
+			ctxs["bar"], "like", false,
+			map[string]int32{"like": 1},
+			[]string{"like"},
+			false,
+		},
+		{
+			"bar finds insightful: success",
+			ctxs["bar"], "insightful", false,
+			map[string]int32{"like": 1, "insightful": 1},
+			[]string{"like", "insightful"},
+			false,
+		},
+		{
+			"baz likes: success",
+			ctxs["baz"], "like", false,
+			map[string]int32{"like": 2, "insightful": 1},
+			[]string{"like"},
+			false,
+		},
+		{
+			"bar takes back like: success",
+			ctxs["bar"], "like", true,
+			map[string]int32{"like": 1, "insightful": 1},
+			[]string{"insightful"},
+			false,
+		},
+		{
+			"unknown kind: invalid argument",
+			ctxs["bar"], "angry", false,
+			nil, nil,
+			true,
+		},
+		{
+			"not logged in: unauthenticated",
+			context.Background(), "like", false,
+			nil, nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		var resp *pb.ArticleResponse
+		var err error
+		if tt.remove {
+			resp, err = h.RemoveArticleReaction(tt.ctx, &pb.RemoveArticleReactionRequest{Slug: slug, Kind: tt.kind})
+		} else {
+			resp, err = h.AddArticleReaction(tt.ctx, &pb.AddArticleReactionRequest{Slug: slug, Kind: tt.kind})
+		}
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if !assert.NoError(t, err, tt.title) {
+			continue
+		}
+		assert.Equal(t, tt.counts, reactionCounts(resp.GetArticle().GetReactions()), tt.title)
+		assert.Equal(t, tt.mine, resp.GetArticle().GetMyReactions(), tt.title)
+		assert.Len(t, resp.GetArticle().GetReactions(), len(model.DefaultReactionKinds), tt.title)
+	}
+
+	// counts are the same for everyone while own reactions differ
+	expected := map[string]int32{"like": 1, "insightful": 1}
+	for _, tt := range []struct {
+		ctx  context.Context
+		mine []string
+	}{
+		{ctxs["foo"], nil},
+		{ctxs["bar"], []string{"insightful"}},
+		{ctxs["baz"], []string{"like"}},
+		{context.Background(), nil},
+	} {
+		got, err := h.GetArticle(tt.ctx, &pb.GetArticleRequest{Slug: slug})
+		if assert.NoError(t, err) {
+			assert.Equal(t, expected, reactionCounts(got.GetArticle().GetReactions()))
+			assert.Equal(t, tt.mine, got.GetArticle().GetMyReactions())
+		}
+
+		list, err := h.GetArticles(tt.ctx, &pb.GetArticlesRequest{})
+		if assert.NoError(t, err) && assert.Len(t, list.GetArticles(), 1) {
+			assert.Equal(t, expected, reactionCounts(list.GetArticles()[0].GetReactions()))
+			assert.Equal(t, tt.mine, list.GetArticles()[0].GetMyReactions())
+		}
+	}
+
+	reactors, err := h.ListArticleReactors(ctxs["foo"], &pb.ListArticleReactorsRequest{Slug: slug, Kind: "insightful"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"bar"}, profileNames(reactors.GetProfiles()))
+	}
+
+	reactors, err = h.ListArticleReactors(ctxs["foo"], &pb.ListArticleReactorsRequest{Slug: slug, Kind: "funny"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, reactors.GetProfiles())
+	}
+}
+
+func TestCommentReactions(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	ctxs := map[string]context.Context{}
+	for _, name := range []string{"foo", "bar"} {
+		u := model.User{
+			Username: name,
+			Email:    name + "@example.com",
+			Password: "secret",
+		}
+		if err := h.us.Create(&u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[name] = ctxWithToken(context.Background(), token)
+	}
+
+	var slugs []string
+	for i := 0; i < 2; i++ {
+		resp, err := h.CreateArticle(ctxs["foo"], &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       "title",
+				Description: "description",
+				Body:        "body",
+				TagList:     []string{"foo"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs = append(slugs, resp.GetArticle().GetSlug())
+	}
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		resp, err := h.CreateComment(ctxs["bar"], &pb.CreateCommentRequest{
+			Slug:    slugs[0],
+			Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+		})
+		if err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+		ids = append(ids, resp.GetComment().GetId())
+	}
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		slug     string
+		id       string
+		kind     string
+		counts   map[string]int32
+		hasError bool
+	}{
+		{"foo finds funny: success", ctxs["foo"], slugs[0], ids[0], "funny", map[string]int32{"funny": 1}, false},
+		{"bar finds own comment funny: success", ctxs["bar"], slugs[0], ids[0], "funny", map[string]int32{"funny": 2}, false},
+		{"foo likes another: success", ctxs["foo"], slugs[0], ids[1], "like", map[string]int32{"like": 1}, false},
+		{"comment of another article: invalid argument", ctxs["foo"], slugs[1], ids[0], "like", nil, true},
+		{"unknown comment: invalid argument", ctxs["foo"], slugs[0], "0", "like", nil, true},
+		{"unknown kind: invalid argument", ctxs["foo"], slugs[0], ids[0], "angry", nil, true},
+	}
+
+	for _, tt := range tests {
+		resp, err := h.AddCommentReaction(tt.ctx, &pb.AddCommentReactionRequest{Slug: tt.slug, Id: tt.id, Kind: tt.kind})
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if assert.NoError(t, err, tt.title) {
+			assert.Equal(t, tt.counts, reactionCounts(resp.GetComment().GetReactions()), tt.title)
+		}
+	}
+
+	comments, err := h.GetComments(ctxs["foo"], &pb.GetCommentsRequest{Slug: slugs[0]})
+	if assert.NoError(t, err) && assert.Len(t, comments.GetComments(), 2) {
+		got := map[string]*pb.Comment{}
+		for _, c := range comments.GetComments() {
+			got[c.GetId()] = c
+		}
+		assert.Equal(t, map[string]int32{"funny": 2}, reactionCounts(got[ids[0]].GetReactions()))
+		assert.Equal(t, []string{"funny"}, got[ids[0]].GetMyReactions())
+		assert.Equal(t, map[string]int32{"like": 1}, reactionCounts(got[ids[1]].GetReactions()))
+		assert.Equal(t, []string{"like"}, got[ids[1]].GetMyReactions())
+	}
+
+	reactors, err := h.ListCommentReactors(ctxs["bar"], &pb.ListCommentReactorsRequest{Slug: slugs[0], Id: ids[0], Kind: "funny"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"foo", "bar"}, profileNames(reactors.GetProfiles()))
+	}
+
+	removed, err := h.RemoveCommentReaction(ctxs["foo"], &pb.RemoveCommentReactionRequest{Slug: slugs[0], Id: ids[0], Kind: "funny"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int32{"funny": 1}, reactionCounts(removed.GetComment().GetReactions()))
+		assert.Empty(t, removed.GetComment().GetMyReactions())
+	}
+
+	// reactions are kept with the deleted comment until it is purged
+	if _, err := h.DeleteComment(ctxs["bar"], &pb.DeleteCommentRequest{Slug: slugs[0], Id: ids[1]}); err != nil {
+		t.Fatalf("failed to delete comment: %v", err)
+	}
+
+	deletedID, err := strconv.Atoi(ids[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts, err := h.as.GetReactionCounts(model.ReactionTargetComment, []uint{uint(deletedID)})
+	if assert.NoError(t, err) {
+		assert.NotEmpty(t, counts)
+	}
+
+	if err := h.PurgeTrash(time.Now().Add(h.cfg.TrashRetention + time.Minute)); err != nil {
+		t.Fatalf("failed to purge trash: %v", err)
+	}
+
+	counts, err = h.as.GetReactionCounts(model.ReactionTargetComment, []uint{uint(deletedID)})
+	if assert.NoError(t, err) {
+		assert.Empty(t, counts)
+	}
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListArticleRevisions lists revisions of current user's article
+func (h *Handler) ListArticleRevisions(ctx context.Context, req *pb.ListArticleRevisionsRequest) (*pb.ArticleRevisionsResponse, error) {
+	h = h.withContext(ctx)
+
+	article, err := h.getOwnArticle(ctx, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	rs, err := h.as.GetRevisions(article, limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get revisions"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	prs := make([]*pb.ArticleRevision, 0, len(rs))
+	for _, r := range rs {
+		pr := r.ProtoArticleRevision()
+		pr.Author = r.Author.ProtoProfile(false)
+		prs = append(prs, pr)
+	}
+
+	return &pb.ArticleRevisionsResponse{Revisions: prs}, nil
+}
+
+// GetArticleRevision gets a revision of current user's article with the diff against current contents
+func (h *Handler) GetArticleRevision(ctx context.Context, req *pb.GetArticleRevisionRequest) (*pb.ArticleRevisionResponse, error) {
+	h = h.withContext(ctx)
+
+	article, err := h.getOwnArticle(ctx, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := h.getRevision(article, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(r.Text()),
+		B:        difflib.SplitLines(article.Text()),
+		FromFile: fmt.Sprintf("revision %d", r.ID),
+		ToFile:   "current",
+		Context:  3,
+	})
+	if err != nil {
+		msg := "failed to compute diff"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pr := r.ProtoArticleRevision()
+	pr.Author = r.Author.ProtoProfile(false)
+
+	return &pb.ArticleRevisionResponse{Revision: pr, Diff: diff}, nil
+}
+
+// RestoreArticleRevision overwrites current user's article with contents of the revision
+func (h *Handler) RestoreArticleRevision(ctx context.Context, req *pb.RestoreArticleRevisionRequest) (*pb.ArticleResponse, error) {
+	h = h.withContext(ctx)
+
+	article, err := h.getOwnArticle(ctx, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := h.getRevision(article, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.as.RestoreRevision(article, r); err != nil {
+		msg := "failed to restore revision"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	h.triggerArticleWebhooks(model.EventArticleUpdated, article, nil)
+
+	// get whether the article is current user's favorite
+	favorited, err := h.as.IsFavorited(article, &article.Author)
+	if err != nil {
+		msg := "failed to get favorited status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa := article.ProtoArticle(favorited)
+
+	// get whether the article is in current user's bookmarks
+	bookmarked, err := h.as.IsBookmarked(article, &article.Author)
+	if err != nil {
+		msg := "failed to get bookmarked status"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+	pa.Bookmarked = bookmarked
+	pa.Author = article.Author.ProtoProfile(false)
+
+	return &pb.ArticleResponse{Article: pa}, nil
+}
+
+// getOwnArticle finds an article written by current user
+func (h *Handler) getOwnArticle(ctx context.Context, slug string) (*model.Article, error) {
+	userID, err := auth.GetUserID(ctx)
+	if err != nil {
+		msg := "unauthenticated"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Errorf(codes.Unauthenticated, msg)
+	}
+
+	currentUser, err := h.us.GetByID(userID)
+	if err != nil {
+		msg := "not user found"
+		err = fmt.Errorf("token is valid but the user not found: %w", err)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, msg)
+	}
+
+	articleID, err := strconv.Atoi(slug)
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert slug (%s) into integer", slug)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	article, err := h.as.GetByID(uint(articleID))
+	if err != nil {
+		msg := fmt.Sprintf("requested article (slug=%d) not found", articleID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	if article.Author.ID != currentUser.ID {
+		msg := fmt.Sprintf("user(id=%d) attempted to access other user's article(id=%d)",
+			currentUser.ID, article.ID)
+		h.logger.Error().Msg(msg)
+		return nil, status.Errorf(codes.Unauthenticated, "forbidden")
+	}
+
+	return article, nil
+}
+
+// getRevision finds a revision of the article
+func (h *Handler) getRevision(article *model.Article, id string) (*model.ArticleRevision, error) {
+	revisionID, err := strconv.Atoi(id)
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert id (%s) into integer", id)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid revision id")
+	}
+
+	r, err := h.as.GetRevisionByID(article, uint(revisionID))
+	if err != nil {
+		msg := fmt.Sprintf("requested revision (id=%d) not found", revisionID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+
+	return r, nil
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticleRevisions(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "first title",
+			Description: "first description",
+			Body:        "first body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	updates := []*pb.UpdateArticleRequest_Article{
+		{Slug: slug, Body: "second body"},
+		{Slug: slug, Body: "second body"}, // no changes, no revision
+		{Slug: slug, Title: "third title"},
+	}
+	for _, u := range updates {
+		if _, err := h.UpdateArticle(fooCtx, &pb.UpdateArticleRequest{Article: u}); err != nil {
+			t.Fatalf("failed to update article: %v", err)
+		}
+	}
+
+	_, err = h.ListArticleRevisions(barCtx, &pb.ListArticleRevisionsRequest{Slug: slug})
+	assert.Error(t, err, "other users cannot see revisions")
+
+	list, err := h.ListArticleRevisions(fooCtx, &pb.ListArticleRevisionsRequest{Slug: slug})
+	if err != nil {
+		t.Fatalf("failed to list revisions: %v", err)
+	}
+	revisions := list.GetRevisions()
+	if !assert.Len(t, revisions, 3) {
+		t.FailNow()
+	}
+	assert.Equal(t, "third title", revisions[0].GetTitle())
+	assert.Equal(t, "second body", revisions[1].GetBody())
+	assert.Equal(t, "first body", revisions[2].GetBody())
+	assert.Equal(t, fooUser.Username, revisions[2].GetAuthor().GetUsername())
+	assert.NotEmpty(t, revisions[2].GetCreatedAt())
+
+	first := revisions[2].GetId()
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		req      *pb.GetArticleRevisionRequest
+		hasError bool
+	}{
+		{
+			"get article revision: success",
+			fooCtx,
+			&pb.GetArticleRevisionRequest{Slug: slug, Id: first},
+			false,
+		},
+		{
+			"get article revision: other user's article",
+			barCtx,
+			&pb.GetArticleRevisionRequest{Slug: slug, Id: first},
+			true,
+		},
+		{
+			"get article revision: unknown revision",
+			fooCtx,
+			&pb.GetArticleRevisionRequest{Slug: slug, Id: "0"},
+			true,
+		},
+		{
+			"get article revision: invalid id",
+			fooCtx,
+			&pb.GetArticleRevisionRequest{Slug: slug, Id: "foo"},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		resp, err := h.GetArticleRevision(tt.ctx, tt.req)
+		if tt.hasError {
+			if err == nil {
+				t.Errorf("%q expected to fail, but succeeded.", tt.title)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q expected to succeed, but failed. %v", tt.title, err)
+			continue
+		}
+
+		assert.Equal(t, "first title", resp.GetRevision().GetTitle())
+		assert.Contains(t, resp.GetDiff(), "-first title")
+		assert.Contains(t, resp.GetDiff(), "+third title")
+		assert.Contains(t, resp.GetDiff(), "-first body")
+		assert.Contains(t, resp.GetDiff(), "+second body")
+	}
+
+	_, err = h.RestoreArticleRevision(barCtx, &pb.RestoreArticleRevisionRequest{Slug: slug, Id: first})
+	assert.Error(t, err, "other users cannot restore revisions")
+
+	restored, err := h.RestoreArticleRevision(fooCtx, &pb.RestoreArticleRevisionRequest{Slug: slug, Id: first})
+	if err != nil {
+		t.Fatalf("failed to restore revision: %v", err)
+	}
+	assert.Equal(t, "first title", restored.GetArticle().GetTitle())
+	assert.Equal(t, "first description", restored.GetArticle().GetDescription())
+	assert.Equal(t, "first body", restored.GetArticle().GetBody())
+
+	got, err := h.GetArticle(fooCtx, &pb.GetArticleRequest{Slug: slug})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "first title", got.GetArticle().GetTitle())
+		assert.Equal(t, "first body", got.GetArticle().GetBody())
+	}
+
+	list, err = h.ListArticleRevisions(fooCtx, &pb.ListArticleRevisionsRequest{Slug: slug})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.GetRevisions(), 4)
+	}
+
+	// revisions are removed with the article
+	articleID, err := strconv.Atoi(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	article, err := h.as.GetByID(uint(articleID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.DeleteArticle(fooCtx, &pb.DeleteArticleRequest{Slug: slug}); err != nil {
+		t.Fatalf("failed to delete article: %v", err)
+	}
+
+	rs, err := h.as.GetRevisions(article, 20, 0)
+	if assert.NoError(t, err) {
+		assert.Empty(t, rs)
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 // GetTags returns all of tags
 func (h *Handler) GetTags(ctx context.Context, req *pb.Empty) (*pb.TagsResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("get tags")
+	h = h.withContext(ctx)
 
 	tags, err := h.as.GetTags()
 	if err != nil {
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestQuerySpans(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(prev)
+
+	// queries of requests out of traces are not recorded
+	if _, err := h.GetTags(context.Background(), &pb.Empty{}); err != nil {
+		t.Fatalf("failed to get tags: %v", err)
+	}
+	assert.Empty(t, sr.Ended())
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "request")
+	_, err := h.CreateUser(ctx, &pb.CreateUserRequest{
+		User: &pb.CreateUserRequest_User{
+			Username: "foo",
+			Email:    "foo@example.com",
+			Password: "secret",
+		},
+	})
+	span.End()
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	var insert sdktrace.ReadOnlySpan
+	for _, s := range sr.Ended() {
+		if s.Name() == "INSERT users" {
+			insert = s
+		}
+	}
+	if insert == nil {
+		t.Fatalf("span of the insert is not recorded")
+	}
+
+	assert.Equal(t, span.SpanContext().TraceID(), insert.SpanContext().TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), insert.Parent().SpanID())
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTrashedArticles gets current user's deleted articles which can be restored
+func (h *Handler) GetTrashedArticles(ctx context.Context, req *pb.GetTrashedArticlesRequest) (*pb.ArticlesResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	as, err := h.as.GetDeletedArticles(currentUser, time.Now().Add(-h.cfg.TrashRetention), limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get trashed articles"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pas := make([]*pb.Article, 0, len(as))
+	for _, a := range as {
+		pa := a.ProtoArticle(false)
+		pa.Author = a.Author.ProtoProfile(false)
+		pas = append(pas, pa)
+	}
+
+	return &pb.ArticlesResponse{Articles: pas, ArticlesCount: int32(len(pas))}, nil
+}
+
+// RestoreArticle restores current user's deleted article
+func (h *Handler) RestoreArticle(ctx context.Context, req *pb.RestoreArticleRequest) (*pb.ArticleResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	articleID, err := strconv.Atoi(req.GetSlug())
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert slug (%s) into integer", req.GetSlug())
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	article, err := h.as.GetDeletedArticleByID(uint(articleID))
+	if err != nil || article.UserID != currentUser.ID || h.expired(article.DeletedAt) {
+		msg := fmt.Sprintf("requested article (slug=%d) is not in the trash of user(id=%d)",
+			articleID, currentUser.ID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "article not found in the trash")
+	}
+
+	if err := h.as.Restore(article); err != nil {
+		msg := "failed to restore article"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	h.triggerArticleWebhooks(model.EventArticleRestored, article, nil)
+
+	return h.articleResponse(article, currentUser)
+}
+
+// GetTrashedComments gets current user's deleted comments which can be restored
+func (h *Handler) GetTrashedComments(ctx context.Context, req *pb.GetTrashedCommentsRequest) (*pb.CommentsResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	cs, err := h.as.GetDeletedComments(currentUser, time.Now().Add(-h.cfg.TrashRetention), limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get trashed comments"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pcs := make([]*pb.Comment, 0, len(cs))
+	for _, c := range cs {
+		pc := c.ProtoComment()
+		pc.Author = c.Author.ProtoProfile(false)
+		pcs = append(pcs, pc)
+	}
+
+	return &pb.CommentsResponse{Comments: pcs}, nil
+}
+
+// RestoreComment restores current user's deleted comment
+func (h *Handler) RestoreComment(ctx context.Context, req *pb.RestoreCommentRequest) (*pb.CommentResponse, error) {
+	h = h.withContext(ctx)
+
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commentID, err := strconv.Atoi(req.GetId())
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert id (%s) into integer", req.GetId())
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid comment id")
+	}
+
+	comment, err := h.as.GetDeletedCommentByID(uint(commentID))
+	if err != nil || comment.UserID != currentUser.ID || h.expired(comment.DeletedAt) {
+		msg := fmt.Sprintf("requested comment (id=%d) is not in the trash of user(id=%d)",
+			commentID, currentUser.ID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "comment not found in the trash")
+	}
+
+	// comments cannot be restored without their article
+	if _, err := h.as.GetByID(comment.ArticleID); err != nil {
+		msg := fmt.Sprintf("article (id=%d) of the comment is deleted", comment.ArticleID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.FailedPrecondition, "the article of the comment is deleted")
+	}
+
+	if err := h.as.RestoreComment(comment); err != nil {
+		msg := "failed to restore comment"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	h.publishComment(commentCreated, comment)
+
+	return h.commentResponse(comment, currentUser)
+}
+
+// PurgeTrash permanently deletes articles and comments deleted longer than the retention window ago
+func (h *Handler) PurgeTrash(now time.Time) error {
+	before := now.Add(-h.cfg.TrashRetention)
+
+	n, err := h.as.PurgeDeletedArticles(before)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted articles: %w", err)
+	}
+	if n > 0 {
+		h.logger.Info().Int64("count", n).Msg("purged deleted articles")
+	}
+
+	n, err = h.as.PurgeDeletedComments(before)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted comments: %w", err)
+	}
+	if n > 0 {
+		h.logger.Info().Int64("count", n).Msg("purged deleted comments")
+	}
+
+	return nil
+}
+
+// expired returns whether the content deleted at t cannot be restored anymore
+func (h *Handler) expired(t *time.Time) bool {
+	return t == nil || time.Since(*t) > h.cfg.TrashRetention
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrash(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	barUser := model.User{
+		Username: "bar",
+		Email:    "bar@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&fooUser, &barUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	barToken, err := auth.GenerateToken(barUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barCtx := ctxWithToken(context.Background(), barToken)
+
+	var slugs, ids []string
+	for _, title := range []string{"first", "second"} {
+		resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       title,
+				Description: "description",
+				Body:        title,
+				TagList:     []string{"foo"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slug := resp.GetArticle().GetSlug()
+		slugs = append(slugs, slug)
+
+		if _, err := h.FavoriteArticle(barCtx, &pb.FavoriteArticleRequest{Slug: slug}); err != nil {
+			t.Fatalf("failed to favorite article: %v", err)
+		}
+
+		if _, err := h.BookmarkArticle(barCtx, &pb.BookmarkArticleRequest{Slug: slug}); err != nil {
+			t.Fatalf("failed to bookmark article: %v", err)
+		}
+
+		comment, err := h.CreateComment(barCtx, &pb.CreateCommentRequest{
+			Slug:    slug,
+			Comment: &pb.CreateCommentRequest_Comment{Body: title},
+		})
+		if err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+		ids = append(ids, comment.GetComment().GetId())
+
+		_, err = h.AddCommentReaction(fooCtx, &pb.AddCommentReactionRequest{
+			Slug: slug, Id: comment.GetComment().GetId(), Kind: "like",
+		})
+		if err != nil {
+			t.Fatalf("failed to add reaction: %v", err)
+		}
+	}
+
+	// delete and restore an article
+	if _, err := h.DeleteArticle(fooCtx, &pb.DeleteArticleRequest{Slug: slugs[0]}); err != nil {
+		t.Fatalf("failed to delete article: %v", err)
+	}
+
+	trash, err := h.GetTrashedArticles(fooCtx, &pb.GetTrashedArticlesRequest{})
+	if assert.NoError(t, err) && assert.Len(t, trash.GetArticles(), 1) {
+		assert.Equal(t, slugs[0], trash.GetArticles()[0].GetSlug())
+		assert.NotEmpty(t, trash.GetArticles()[0].GetDeletedAt())
+	}
+
+	trash, err = h.GetTrashedArticles(barCtx, &pb.GetTrashedArticlesRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, trash.GetArticles())
+	}
+
+	restores := []struct {
+		title    string
+		ctx      context.Context
+		slug     string
+		hasError bool
+	}{
+		{"restore other user's article: not found", barCtx, slugs[0], true},
+		{"restore article not deleted: not found", fooCtx, slugs[1], true},
+		{"restore article: unauthenticated", context.Background(), slugs[0], true},
+		{"restore article: success", fooCtx, slugs[0], false},
+		{"restore article twice: not found", fooCtx, slugs[0], true},
+	}
+
+	for _, tt := range restores {
+		resp, err := h.RestoreArticle(tt.ctx, &pb.RestoreArticleRequest{Slug: tt.slug})
+		if tt.hasError {
+			assert.Error(t, err, tt.title)
+			continue
+		}
+
+		if assert.NoError(t, err, tt.title) {
+			assert.Empty(t, resp.GetArticle().GetDeletedAt(), tt.title)
+			assert.Equal(t, int32(1), resp.GetArticle().GetFavoritesCount(), tt.title)
+		}
+	}
+
+	_, err = h.GetArticle(barCtx, &pb.GetArticleRequest{Slug: slugs[0]})
+	assert.NoError(t, err)
+
+	revisions, err := h.ListArticleRevisions(fooCtx, &pb.ListArticleRevisionsRequest{Slug: slugs[0]})
+	if assert.NoError(t, err) {
+		assert.Len(t, revisions.GetRevisions(), 1)
+	}
+
+	// counters are back
+	profile, err := h.ShowProfile(barCtx, &pb.ShowProfileRequest{Username: "foo"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(2), profile.GetProfile().GetArticlesCount())
+		assert.Equal(t, int32(2), profile.GetProfile().GetFavoritesCount())
+	}
+
+	// delete and restore a comment
+	if _, err := h.DeleteComment(barCtx, &pb.DeleteCommentRequest{Slug: slugs[0], Id: ids[0]}); err != nil {
+		t.Fatalf("failed to delete comment: %v", err)
+	}
+
+	comments, err := h.GetTrashedComments(barCtx, &pb.GetTrashedCommentsRequest{})
+	if assert.NoError(t, err) && assert.Len(t, comments.GetComments(), 1) {
+		assert.Equal(t, ids[0], comments.GetComments()[0].GetId())
+	}
+
+	_, err = h.RestoreComment(fooCtx, &pb.RestoreCommentRequest{Id: ids[0]})
+	assert.Error(t, err, "restore other user's comment")
+
+	restored, err := h.RestoreComment(barCtx, &pb.RestoreCommentRequest{Id: ids[0]})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ids[0], restored.GetComment().GetId())
+		assert.Equal(t, map[string]int32{"like": 1}, reactionCounts(restored.GetComment().GetReactions()),
+			"reactions are restored")
+	}
+
+	got, err := h.GetComments(barCtx, &pb.GetCommentsRequest{Slug: slugs[0]})
+	if assert.NoError(t, err) {
+		assert.Contains(t, commentIDs(got.GetComments()), ids[0])
+	}
+
+	// comments of deleted articles cannot be restored
+	if _, err := h.DeleteComment(barCtx, &pb.DeleteCommentRequest{Slug: slugs[1], Id: ids[1]}); err != nil {
+		t.Fatalf("failed to delete comment: %v", err)
+	}
+	if _, err := h.DeleteArticle(fooCtx, &pb.DeleteArticleRequest{Slug: slugs[1]}); err != nil {
+		t.Fatalf("failed to delete article: %v", err)
+	}
+
+	comments, err = h.GetTrashedComments(barCtx, &pb.GetTrashedCommentsRequest{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, comments.GetComments())
+	}
+
+	_, err = h.RestoreComment(barCtx, &pb.RestoreCommentRequest{Id: ids[1]})
+	assert.Error(t, err)
+
+	// contents out of the retention window cannot be restored
+	func() {
+		defer func(d time.Duration) { h.cfg.TrashRetention = d }(h.cfg.TrashRetention)
+		// deleted_at is stored in seconds, so move the window past it
+		h.cfg.TrashRetention = -time.Minute
+
+		trash, err := h.GetTrashedArticles(fooCtx, &pb.GetTrashedArticlesRequest{})
+		if assert.NoError(t, err) {
+			assert.Empty(t, trash.GetArticles())
+		}
+
+		_, err = h.RestoreArticle(fooCtx, &pb.RestoreArticleRequest{Slug: slugs[1]})
+		assert.Error(t, err)
+	}()
+
+	// purge expired contents
+	if err := h.PurgeTrash(time.Now().Add(h.cfg.TrashRetention + time.Minute)); err != nil {
+		t.Fatalf("failed to purge trash: %v", err)
+	}
+
+	purgedID, err := strconv.Atoi(slugs[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	purged := model.Article{}
+	purged.ID = uint(purgedID)
+
+	_, err = h.as.GetDeletedArticleByID(purged.ID)
+	assert.Error(t, err, "article is purged")
+
+	favorited, err := h.as.IsFavorited(&purged, &barUser)
+	if assert.NoError(t, err) {
+		assert.False(t, favorited, "favorites are purged")
+	}
+
+	bookmarked, err := h.as.IsBookmarked(&purged, &barUser)
+	if assert.NoError(t, err) {
+		assert.False(t, bookmarked, "bookmarks are purged")
+	}
+
+	purgedCommentID, err := strconv.Atoi(ids[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.as.GetDeletedCommentByID(uint(purgedCommentID))
+	assert.Error(t, err, "comment is purged")
+
+	reactions, err := h.as.GetReactionCounts(model.ReactionTargetComment, []uint{uint(purgedCommentID)})
+	if assert.NoError(t, err) {
+		assert.Empty(t, reactions, "reactions are purged")
+	}
+
+	// contents which are not deleted are kept
+	_, err = h.GetArticle(barCtx, &pb.GetArticleRequest{Slug: slugs[0]})
+	assert.NoError(t, err)
+
+	got, err = h.GetComments(barCtx, &pb.GetCommentsRequest{Slug: slugs[0]})
+	if assert.NoError(t, err) {
+		assert.Len(t, got.GetComments(), 1)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/filter"
+	"github.com/raahii/golang-grpc-realworld-example/metrics"
 	"github.com/raahii/golang-grpc-realworld-example/model"
 	pb "github.com/raahii/golang-grpc-realworld-example/proto"
 	"google.golang.org/grpc/codes"
@@ -13,7 +15,7 @@ import (
 
 // LoginUser is existing user login
 func (h *Handler) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.UserResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("login user")
+	h = h.withContext(ctx)
 
 	u, err := h.us.GetByEmail(req.GetUser().GetEmail())
 	if err != nil {
@@ -28,6 +30,11 @@ func (h *Handler) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.
 		return nil, status.Error(codes.InvalidArgument, "invalid email or password")
 	}
 
+	if u.IsSuspended() {
+		h.logger.Error().Msgf("suspended user attempted to login: %s", u.Email)
+		return nil, status.Error(codes.PermissionDenied, "account suspended")
+	}
+
 	token, err := auth.GenerateToken(u.ID)
 	if err != nil {
 		msg := "internal server error"
@@ -41,7 +48,7 @@ func (h *Handler) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.
 
 // CreateUser registers a new user
 func (h *Handler) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.UserResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("create user")
+	h = h.withContext(ctx)
 
 	u := model.User{
 		Username: req.User.GetUsername(),
@@ -73,6 +80,8 @@ func (h *Handler) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*p
 		return nil, status.Error(codes.Canceled, msg)
 	}
 
+	metrics.Signups.Inc()
+
 	token, err := auth.GenerateToken(u.ID)
 	if err != nil {
 		msg := "internal server error"
@@ -86,21 +95,12 @@ func (h *Handler) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*p
 
 // CurrentUser gets a current user
 func (h *Handler) CurrentUser(ctx context.Context, req *pb.Empty) (*pb.UserResponse, error) {
-	h.logger.Info().Interface("req", req).Msg("get current user")
-
-	userID, err := auth.GetUserID(ctx)
-	if err != nil {
-		msg := "unauthenticated"
-		h.logger.Error().Err(err).Msg(msg)
-		return nil, status.Errorf(codes.Unauthenticated, msg)
-	}
+	h = h.withContext(ctx)
 
-	u, err := h.us.GetByID(userID)
+	// not to issue a new token for a deleted account
+	u, err := h.currentUser(ctx)
 	if err != nil {
-		msg := "user not found"
-		err = fmt.Errorf("token is valid but the user not found: %w", err)
-		h.logger.Error().Err(err).Msg(msg)
-		return nil, status.Error(codes.NotFound, msg)
+		return nil, err
 	}
 
 	token, err := auth.GenerateToken(u.ID)
@@ -116,7 +116,7 @@ func (h *Handler) CurrentUser(ctx context.Context, req *pb.Empty) (*pb.UserRespo
 
 // UpdateUser updates current user
 func (h *Handler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
-	h.logger.Info().Msg("update user request")
+	h = h.withContext(ctx)
 
 	userID, err := auth.GetUserID(ctx)
 	if err != nil {
@@ -167,6 +167,18 @@ func (h *Handler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*p
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// bios cannot be held for moderation, so held bios are rejected as well
+	if bio != "" {
+		decision, err := h.filterContent(ctx, filter.KindBio, u, bio)
+		if err != nil {
+			return nil, err
+		}
+		if decision.Action == filter.Hold {
+			msg := fmt.Sprintf("content rejected: %s", decision.Reason)
+			return nil, status.Error(codes.InvalidArgument, msg)
+		}
+	}
+
 	if req.GetUser().GetPassword() != "" {
 		err = u.HashPassword()
 		if err != nil {
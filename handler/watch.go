@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/raahii/golang-grpc-realworld-example/pubsub"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// types of comment event
+const (
+	commentCreated = "created"
+	commentDeleted = "deleted"
+)
+
+// publishedArticlesTopic is the topic of newly published articles
+const publishedArticlesTopic = "articles.published"
+
+// commentsTopic returns the topic of comment events of the article
+func commentsTopic(articleID uint) string {
+	return fmt.Sprintf("articles.%d.comments", articleID)
+}
+
+type articleEvent struct {
+	ArticleID uint `json:"article_id"`
+	AuthorID  uint `json:"author_id"`
+}
+
+type commentEvent struct {
+	Type      string `json:"type"`
+	CommentID uint   `json:"comment_id"`
+}
+
+// WatchFeed streams articles of followed users as they are published
+func (h *Handler) WatchFeed(req *pb.Empty, stream pb.Articles_WatchFeedServer) error {
+	ctx := stream.Context()
+	h = h.withContext(ctx)
+	currentUser, err := h.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	sub, err := h.ps.Subscribe(ctx, publishedArticlesTopic)
+	if err != nil {
+		msg := "failed to subscribe feed"
+		h.logger.Error().Err(err).Msg(msg)
+		return status.Error(codes.Unavailable, msg)
+	}
+	defer sub.Close()
+
+	for data := range sub.Messages() {
+		var e articleEvent
+		if err := json.Unmarshal(data, &e); err != nil {
+			h.logger.Error().Err(err).Msg("invalid article event")
+			continue
+		}
+
+		author := model.User{Model: gorm.Model{ID: e.AuthorID}}
+		following, err := h.us.IsFollowing(currentUser, &author)
+		if err != nil {
+			msg := "failed to get following status"
+			h.logger.Error().Err(err).Msg(msg)
+			return status.Error(codes.Aborted, "internal server error")
+		}
+		if !following {
+			continue
+		}
+
+		hidden, err := h.isHidden(currentUser, &author)
+		if err != nil {
+			msg := "failed to get hidden status"
+			h.logger.Error().Err(err).Msg(msg)
+			return status.Error(codes.Aborted, "internal server error")
+		}
+		if hidden {
+			continue
+		}
+
+		article, err := h.as.GetByID(e.ArticleID)
+		if err != nil {
+			// deleted before delivered
+			h.logger.Error().Err(err).Uint("article_id", e.ArticleID).Msg("published article not found")
+			continue
+		}
+		if article.IsHidden() {
+			continue
+		}
+
+		favorited, err := h.as.IsFavorited(article, currentUser)
+		if err != nil {
+			msg := "failed to get favorited status"
+			h.logger.Error().Err(err).Msg(msg)
+			return status.Error(codes.Aborted, "internal server error")
+		}
+		pa := article.ProtoArticle(favorited)
+
+		// articles are streamed one by one as they are published, so the
+		// bookmarks are looked up the same way as pages of a single article
+		bookmarked, err := h.as.GetBookmarkedIDs(currentUser, []uint{article.ID})
+		if err != nil {
+			msg := "failed to get bookmarked status"
+			h.logger.Error().Err(err).Msg(msg)
+			return status.Error(codes.Aborted, "internal server error")
+		}
+		pa.Bookmarked = bookmarked[article.ID]
+		pa.Author = article.Author.ProtoProfile(following)
+
+		if err := stream.Send(&pb.ArticleResponse{Article: pa}); err != nil {
+			h.logger.Info().Err(err).Msg("feed watcher disconnected")
+			return err
+		}
+	}
+
+	return h.subscriptionError(ctx, sub)
+}
+
+// WatchComments streams comments of the article as they are created or deleted
+func (h *Handler) WatchComments(req *pb.WatchCommentsRequest, stream pb.Articles_WatchCommentsServer) error {
+	ctx := stream.Context()
+	h = h.withContext(ctx)
+
+	articleID, err := strconv.Atoi(req.GetSlug())
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert slug (%s) into integer", req.GetSlug())
+		h.logger.Error().Err(err).Msg(msg)
+		return status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	article, err := h.as.GetByID(uint(articleID))
+	if err != nil {
+		msg := fmt.Sprintf("requested article (slug=%d) not found", articleID)
+		h.logger.Error().Err(err).Msg(msg)
+		return status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	var currentUser *model.User
+	userID, err := auth.GetUserID(ctx)
+	if err == nil {
+		currentUser, err = h.us.GetByID(userID)
+		if err != nil {
+			h.logger.Error().Err(err).Msg("current user not found")
+			return status.Error(codes.NotFound, "user not found")
+		}
+	}
+
+	if !article.VisibleTo(currentUser) {
+		msg := fmt.Sprintf("requested article (slug=%d) is not published", articleID)
+		h.logger.Error().Msg(msg)
+		return status.Error(codes.InvalidArgument, "invalid article id")
+	}
+
+	sub, err := h.ps.Subscribe(ctx, commentsTopic(article.ID))
+	if err != nil {
+		msg := "failed to subscribe comments"
+		h.logger.Error().Err(err).Msg(msg)
+		return status.Error(codes.Unavailable, msg)
+	}
+	defer sub.Close()
+
+	for data := range sub.Messages() {
+		var e commentEvent
+		if err := json.Unmarshal(data, &e); err != nil {
+			h.logger.Error().Err(err).Msg("invalid comment event")
+			continue
+		}
+
+		pe := pb.CommentEvent{
+			Type:    e.Type,
+			Comment: &pb.Comment{Id: fmt.Sprintf("%d", e.CommentID)},
+		}
+
+		if e.Type == commentCreated {
+			c, err := h.as.GetCommentByID(e.CommentID)
+			if err != nil {
+				// deleted before delivered
+				h.logger.Error().Err(err).Uint("comment_id", e.CommentID).Msg("created comment not found")
+				continue
+			}
+			if c.IsHidden() {
+				continue
+			}
+
+			hidden, err := h.isHidden(currentUser, &c.Author)
+			if err != nil {
+				msg := "failed to get hidden status"
+				h.logger.Error().Err(err).Msg(msg)
+				return status.Error(codes.Aborted, "internal server error")
+			}
+			if hidden {
+				continue
+			}
+
+			following, err := h.us.IsFollowing(currentUser, &c.Author)
+			if err != nil {
+				msg := "failed to get following status"
+				h.logger.Error().Err(err).Msg(msg)
+				return status.Error(codes.Aborted, "internal server error")
+			}
+			pe.Comment = c.ProtoComment()
+			pe.Comment.Author = c.Author.ProtoProfile(following)
+		}
+
+		if err := stream.Send(&pe); err != nil {
+			h.logger.Info().Err(err).Msg("comment watcher disconnected")
+			return err
+		}
+	}
+
+	return h.subscriptionError(ctx, sub)
+}
+
+// subscriptionError converts the reason why the subscription ended into a status
+func (h *Handler) subscriptionError(ctx context.Context, sub pubsub.Subscription) error {
+	err := sub.Err()
+	if errors.Is(err, pubsub.ErrSlowSubscriber) {
+		h.logger.Error().Err(err).Msg("watcher dropped")
+		return status.Error(codes.ResourceExhausted, "too many pending events, please reconnect")
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Msg("subscription failed")
+		return status.Error(codes.Unavailable, "subscription failed")
+	}
+
+	// the client disconnected
+	return ctx.Err()
+}
+
+// publishArticle notifies watchers that the article is published
+func (h *Handler) publishArticle(a *model.Article) {
+	h.publish(publishedArticlesTopic, articleEvent{ArticleID: a.ID, AuthorID: a.UserID})
+}
+
+// publishComment notifies watchers of the article that the comment is created or deleted
+func (h *Handler) publishComment(typ string, c *model.Comment) {
+	h.publish(commentsTopic(c.ArticleID), commentEvent{Type: typ, CommentID: c.ID})
+}
+
+// publish sends an event to watchers. Failures are only logged
+// not to fail the action which caused the event.
+func (h *Handler) publish(topic string, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		h.logger.Error().Err(err).Str("topic", topic).Msg("failed to encode event")
+		return
+	}
+
+	if err := h.ps.Publish(context.Background(), topic, data); err != nil {
+		h.logger.Error().Err(err).Str("topic", topic).Msg("failed to publish event")
+	}
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/raahii/golang-grpc-realworld-example/pubsub"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// feedStream is a fake server stream of WatchFeed
+type feedStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.ArticleResponse
+}
+
+func (s *feedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *feedStream) Send(resp *pb.ArticleResponse) error {
+	select {
+	case s.sent <- resp:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// commentStream is a fake server stream of WatchComments
+type commentStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.CommentEvent
+}
+
+func (s *commentStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *commentStream) Send(e *pb.CommentEvent) error {
+	select {
+	case s.sent <- e:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// waitSubscribers waits until the topic has n subscribers
+func waitSubscribers(t *testing.T, ps *pubsub.Memory, topic string, n int) {
+	for i := 0; i < 100; i++ {
+		if ps.Subscribers(topic) == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("topic %q does not have %d subscribers", topic, n)
+}
+
+func TestWatchFeed(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	ps := pubsub.NewMemory(pubsub.DefaultBufferSize)
+	h.ps = ps
+
+	fooUser := model.User{Username: "foo", Email: "foo@example.com", Password: "secret"}
+	barUser := model.User{Username: "bar", Email: "bar@example.com", Password: "secret"}
+	bazUser := model.User{Username: "baz", Email: "baz@example.com", Password: "secret"}
+
+	ctxs := map[string]context.Context{}
+	for _, u := range []*model.User{&fooUser, &barUser, &bazUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+		token, err := auth.GenerateToken(u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxs[u.Username] = ctxWithToken(context.Background(), token)
+	}
+
+	if err := h.us.Follow(&fooUser, &barUser); err != nil {
+		t.Fatalf("failed to follow user: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctxs["foo"])
+	stream := &feedStream{ctx: ctx, sent: make(chan *pb.ArticleResponse, 10)}
+	done := make(chan error)
+	go func() {
+		done <- h.WatchFeed(&pb.Empty{}, stream)
+	}()
+	waitSubscribers(t, ps, publishedArticlesTopic, 1)
+
+	create := func(user, title, status string) string {
+		resp, err := h.CreateArticle(ctxs[user], &pb.CreateAritcleRequest{
+			Article: &pb.CreateAritcleRequest_Article{
+				Title:       title,
+				Description: "description",
+				Body:        "body",
+				TagList:     []string{"foo"},
+				Status:      status,
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		return resp.GetArticle().GetSlug()
+	}
+
+	create("baz", "not followed", "")
+	draft := create("bar", "draft", model.ArticleStatusDraft)
+	create("bar", "published", "")
+
+	if _, err := h.PublishArticle(ctxs["bar"], &pb.PublishArticleRequest{Slug: draft}); err != nil {
+		t.Fatalf("failed to publish article: %v", err)
+	}
+
+	for _, title := range []string{"published", "draft"} {
+		select {
+		case resp := <-stream.sent:
+			assert.Equal(t, title, resp.GetArticle().GetTitle())
+			assert.Equal(t, "bar", resp.GetArticle().GetAuthor().GetUsername())
+			assert.True(t, resp.GetArticle().GetAuthor().GetFollowing())
+		case <-time.After(time.Second):
+			t.Fatalf("article %q was not streamed", title)
+		}
+	}
+
+	select {
+	case resp := <-stream.sent:
+		t.Errorf("unexpected article: %v", resp.GetArticle().GetTitle())
+	default:
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("WatchFeed did not return after the client disconnected")
+	}
+	waitSubscribers(t, ps, publishedArticlesTopic, 0)
+
+	err := h.WatchFeed(&pb.Empty{}, &feedStream{ctx: context.Background()})
+	assert.Error(t, err, "unauthenticated user cannot watch feed")
+}
+
+func TestWatchComments(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	ps := pubsub.NewMemory(pubsub.DefaultBufferSize)
+	h.ps = ps
+
+	fooUser := model.User{Username: "foo", Email: "foo@example.com", Password: "secret"}
+	if err := h.us.Create(&fooUser); err != nil {
+		t.Fatalf("failed to create initial user record: %v", err)
+	}
+	token, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), token)
+
+	resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+	articleID, err := strconv.Atoi(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	article, err := h.as.GetByID(uint(articleID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	topic := commentsTopic(article.ID)
+
+	err = h.WatchComments(&pb.WatchCommentsRequest{Slug: "0"}, &commentStream{ctx: context.Background()})
+	assert.Error(t, err, "unknown article cannot be watched")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &commentStream{ctx: ctx, sent: make(chan *pb.CommentEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- h.WatchComments(&pb.WatchCommentsRequest{Slug: slug}, stream)
+	}()
+	waitSubscribers(t, ps, topic, 1)
+
+	created, err := h.CreateComment(fooCtx, &pb.CreateCommentRequest{
+		Slug:    slug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "hello"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+	id := created.GetComment().GetId()
+
+	select {
+	case got := <-stream.sent:
+		assert.Equal(t, commentCreated, got.GetType())
+		assert.Equal(t, id, got.GetComment().GetId())
+		assert.Equal(t, "hello", got.GetComment().GetBody())
+		assert.Equal(t, "foo", got.GetComment().GetAuthor().GetUsername())
+	case <-time.After(time.Second):
+		t.Fatal("created event was not streamed")
+	}
+
+	_, err = h.DeleteComment(fooCtx, &pb.DeleteCommentRequest{Slug: slug, Id: id})
+	if err != nil {
+		t.Fatalf("failed to delete comment: %v", err)
+	}
+
+	select {
+	case got := <-stream.sent:
+		assert.Equal(t, commentDeleted, got.GetType())
+		assert.Equal(t, id, got.GetComment().GetId())
+	case <-time.After(time.Second):
+		t.Fatal("deleted event was not streamed")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("WatchComments did not return after the client disconnected")
+	}
+
+	// a watcher which cannot keep up is dropped
+	h.ps = pubsub.NewMemory(1)
+	slow := &commentStream{ctx: context.Background(), sent: make(chan *pb.CommentEvent)}
+	go func() {
+		done <- h.WatchComments(&pb.WatchCommentsRequest{Slug: slug}, slow)
+	}()
+	waitSubscribers(t, h.ps.(*pubsub.Memory), topic, 1)
+
+	// publish until the watcher blocked in sending overflows
+	for i := 0; h.ps.(*pubsub.Memory).Subscribers(topic) > 0; i++ {
+		if i > 100 {
+			t.Fatal("slow watcher was not dropped")
+		}
+		h.publishComment(commentDeleted, &model.Comment{ArticleID: article.ID})
+	}
+
+	// buffered events are still delivered, then the stream ends
+	for {
+		select {
+		case <-slow.sent:
+			continue
+		case err := <-done:
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		case <-time.After(time.Second):
+			t.Fatal("slow watcher did not return")
+		}
+		break
+	}
+}
@@ -0,0 +1,447 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/raahii/golang-grpc-realworld-example/webhook"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// deliveryBatchSize is the maximum number of deliveries sent in a run
+const deliveryBatchSize = 100
+
+// deliveryTimeout is how long a run may take to send its deliveries
+const deliveryTimeout = 30 * time.Second
+
+// deliveryLease is how long a claimed delivery is kept from other servers.
+// It is longer than deliveryTimeout so that a delivery is sent once.
+const deliveryLease = 2 * deliveryTimeout
+
+// CreateWebhook creates a webhook
+func (h *Handler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.WebhookResponse, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	w := model.Webhook{
+		URL:    req.GetWebhook().GetUrl(),
+		Secret: req.GetWebhook().GetSecret(),
+		Active: req.GetWebhook().GetActive(),
+	}
+	w.SetEvents(req.GetWebhook().GetEvents())
+
+	if err := w.Validate(); err != nil {
+		msg := "validation error"
+		err = fmt.Errorf("validation error: %w", err)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.ws.Create(&w); err != nil {
+		msg := "failed to create webhook"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return &pb.WebhookResponse{Webhook: w.ProtoWebhook()}, nil
+}
+
+// ListWebhooks gets all webhooks
+func (h *Handler) ListWebhooks(ctx context.Context, req *pb.Empty) (*pb.WebhooksResponse, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	ws, err := h.ws.GetWebhooks()
+	if err != nil {
+		msg := "failed to get webhooks"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pws := make([]*pb.Webhook, 0, len(ws))
+	for _, w := range ws {
+		pws = append(pws, w.ProtoWebhook())
+	}
+
+	return &pb.WebhooksResponse{Webhooks: pws}, nil
+}
+
+// UpdateWebhook updates a webhook
+func (h *Handler) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.WebhookResponse, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	w, err := h.getWebhook(req.GetWebhook().GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	pw := req.GetWebhook()
+	w.URL = pw.GetUrl()
+	if pw.GetSecret() != "" {
+		w.Secret = pw.GetSecret()
+	}
+	w.SetEvents(pw.GetEvents())
+	w.Active = pw.GetActive()
+
+	if err := w.Validate(); err != nil {
+		msg := "validation error"
+		err = fmt.Errorf("validation error: %w", err)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.ws.Update(w); err != nil {
+		msg := "failed to update webhook"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return &pb.WebhookResponse{Webhook: w.ProtoWebhook()}, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (h *Handler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.Empty, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	w, err := h.getWebhook(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.ws.Delete(w); err != nil {
+		msg := "failed to delete webhook"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// ListWebhookDeliveries gets the delivery log of a webhook
+func (h *Handler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.WebhookDeliveriesResponse, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	w, err := h.getWebhook(req.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+
+	limitQuery := req.GetLimit()
+	if limitQuery == 0 {
+		limitQuery = 20
+	}
+
+	ds, err := h.ws.GetDeliveries(w, limitQuery, req.GetOffset())
+	if err != nil {
+		msg := "failed to get webhook deliveries"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	pds := make([]*pb.WebhookDelivery, 0, len(ds))
+	for _, d := range ds {
+		pds = append(pds, d.ProtoWebhookDelivery())
+	}
+
+	return &pb.WebhookDeliveriesResponse{Deliveries: pds}, nil
+}
+
+// GetWebhookDelivery gets a delivery of a webhook
+func (h *Handler) GetWebhookDelivery(ctx context.Context, req *pb.GetWebhookDeliveryRequest) (*pb.WebhookDeliveryResponse, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	d, err := h.getWebhookDelivery(req.GetWebhookId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.WebhookDeliveryResponse{Delivery: d.ProtoWebhookDelivery()}, nil
+}
+
+// ReplayWebhookDelivery queues the payload of a delivery again
+func (h *Handler) ReplayWebhookDelivery(ctx context.Context, req *pb.GetWebhookDeliveryRequest) (*pb.WebhookDeliveryResponse, error) {
+	h = h.withContext(ctx)
+
+	if _, err := h.currentAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	w, err := h.getWebhook(req.GetWebhookId())
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := h.getWebhookDelivery(req.GetWebhookId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	replay := model.NewWebhookDelivery(w, d.Event, d.Payload, time.Now())
+	if err := h.ws.CreateDelivery(replay); err != nil {
+		msg := "failed to create webhook delivery"
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.Aborted, "internal server error")
+	}
+
+	return &pb.WebhookDeliveryResponse{Delivery: replay.ProtoWebhookDelivery()}, nil
+}
+
+func (h *Handler) getWebhook(id string) (*model.Webhook, error) {
+	webhookID, err := strconv.Atoi(id)
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert id (%s) into integer", id)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid webhook id")
+	}
+
+	w, err := h.ws.GetByID(uint(webhookID))
+	if err != nil {
+		msg := fmt.Sprintf("requested webhook (id=%d) not found", webhookID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	return w, nil
+}
+
+func (h *Handler) getWebhookDelivery(webhookID, id string) (*model.WebhookDelivery, error) {
+	w, err := h.getWebhook(webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryID, err := strconv.Atoi(id)
+	if err != nil {
+		msg := fmt.Sprintf("cannot convert id (%s) into integer", id)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.InvalidArgument, "invalid delivery id")
+	}
+
+	d, err := h.ws.GetDeliveryByID(w, uint(deliveryID))
+	if err != nil {
+		msg := fmt.Sprintf("requested delivery (id=%d) not found", deliveryID)
+		h.logger.Error().Err(err).Msg(msg)
+		return nil, status.Error(codes.NotFound, "delivery not found")
+	}
+
+	return d, nil
+}
+
+// triggerWebhooks queues deliveries of the event to subscribing webhooks.
+// Failures are only logged not to fail the action which caused the event.
+func (h *Handler) triggerWebhooks(event string, data map[string]proto.Message) {
+	ws, err := h.ws.GetActiveWebhooks(event)
+	if err != nil {
+		h.logger.Error().Err(err).Str("event", event).Msg("failed to get webhooks")
+		return
+	}
+	if len(ws) == 0 {
+		return
+	}
+
+	now := time.Now()
+	payload, err := webhookPayload(event, data, now)
+	if err != nil {
+		h.logger.Error().Err(err).Str("event", event).Msg("failed to encode webhook payload")
+		return
+	}
+
+	for _, w := range ws {
+		d := model.NewWebhookDelivery(&w, event, payload, now)
+		if err := h.ws.CreateDelivery(d); err != nil {
+			h.logger.Error().Err(err).
+				Uint("webhook_id", w.ID).
+				Str("event", event).
+				Msg("failed to create webhook delivery")
+		}
+	}
+}
+
+// triggerArticleWebhooks queues deliveries of an article event with other
+// messages related to the event if any. Events of drafts
+// and scheduled articles are not delivered, so that integrations never see
+// unpublished content; they receive the article as created when it is published.
+func (h *Handler) triggerArticleWebhooks(event string, a *model.Article, extra map[string]proto.Message) {
+	if !a.IsPublished() {
+		return
+	}
+
+	pa := a.ProtoArticle(false)
+	pa.Author = a.Author.ProtoProfile(false)
+
+	data := map[string]proto.Message{"article": pa}
+	for k, v := range extra {
+		data[k] = v
+	}
+
+	h.triggerWebhooks(event, data)
+}
+
+// webhookPayload encodes the event with proto messages in the same form as the gateway
+func webhookPayload(event string, data map[string]proto.Message, now time.Time) (string, error) {
+	m := jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+	fields := make(map[string]json.RawMessage, len(data))
+	for k, v := range data {
+		var buf bytes.Buffer
+		if err := m.Marshal(&buf, v); err != nil {
+			return "", err
+		}
+		fields[k] = buf.Bytes()
+	}
+
+	b, err := json.Marshal(struct {
+		Event     string                     `json:"event"`
+		CreatedAt string                     `json:"createdAt"`
+		Data      map[string]json.RawMessage `json:"data"`
+	}{event, now.Format(model.ISO8601), fields})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// DeliverWebhooks sends pending webhook deliveries whose next attempt time
+// has come. Deliveries are claimed before they are sent, and ones to
+// different webhooks are sent concurrently so that a slow receiver does not
+// hold up the others.
+func (h *Handler) DeliverWebhooks(now time.Time) error {
+	ds, err := h.ws.GetDueDeliveries(now, deliveryBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+
+	claimed := map[uint][]*model.WebhookDelivery{}
+	for i := range ds {
+		d := &ds[i]
+
+		ok, err := h.ws.ClaimDelivery(d, now, now.Add(deliveryLease))
+		if err != nil {
+			return fmt.Errorf("failed to claim webhook delivery: %w", err)
+		}
+		if ok {
+			claimed[d.WebhookID] = append(claimed[d.WebhookID], d)
+		}
+	}
+
+	webhooks := make(map[uint]*model.Webhook, len(claimed))
+	for id := range claimed {
+		w, err := h.ws.GetByID(id)
+		if err != nil {
+			if !gorm.IsRecordNotFoundError(err) {
+				return fmt.Errorf("failed to get webhook: %w", err)
+			}
+			// removed after the event
+			w = nil
+		}
+		webhooks[id] = w
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancel()
+
+	var g errgroup.Group
+	for id, ds := range claimed {
+		w, ds := webhooks[id], ds
+		g.Go(func() error {
+			// deliveries to a webhook are sent in order
+			for _, d := range ds {
+				if ctx.Err() != nil {
+					// the rest are sent again once their leases expire
+					return nil
+				}
+
+				h.deliverWebhook(ctx, w, d, now)
+
+				if err := h.ws.UpdateDelivery(d); err != nil {
+					return fmt.Errorf("failed to update webhook delivery: %w", err)
+				}
+			}
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+// deliverWebhook makes an attempt of the delivery and records the result
+func (h *Handler) deliverWebhook(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery, now time.Time) {
+	if w == nil || !w.Active {
+		d.Status = model.DeliveryFailed
+		d.NextAttemptAt = nil
+		d.Error = "webhook is not active"
+		return
+	}
+
+	d.Attempts++
+	resp, err := h.wh.Send(ctx, &webhook.Request{
+		URL:        w.URL,
+		Secret:     w.Secret,
+		Event:      d.Event,
+		DeliveryID: d.ID,
+		Payload:    []byte(d.Payload),
+	}, now)
+
+	d.ResponseStatus, d.ResponseBody = 0, ""
+	if resp != nil {
+		d.ResponseStatus, d.ResponseBody = resp.StatusCode, resp.Body
+	}
+
+	if err == nil {
+		h.logger.Info().Uint("delivery_id", d.ID).Str("event", d.Event).Msg("delivered webhook")
+		d.Status = model.DeliverySucceeded
+		d.Error = ""
+		d.NextAttemptAt = nil
+		d.DeliveredAt = &now
+		return
+	}
+
+	h.logger.Error().Err(err).
+		Uint("delivery_id", d.ID).
+		Int("attempts", d.Attempts).
+		Msg("failed to deliver webhook")
+	d.Error = err.Error()
+
+	if d.Attempts >= webhook.MaxAttempts {
+		d.Status = model.DeliveryFailed
+		d.NextAttemptAt = nil
+		return
+	}
+
+	next := now.Add(webhook.Backoff(d.Attempts))
+	d.NextAttemptAt = &next
+}
@@ -0,0 +1,545 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/raahii/golang-grpc-realworld-example/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+const webhookSecret = "0123456789abcdef"
+
+// receiver records webhook requests whose signature is valid
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	payloads []map[string]interface{}
+	invalid  int
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	body, _ := ioutil.ReadAll(r.Body)
+	ts, _ := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+	if !webhook.Verify(webhookSecret, ts, body, r.Header.Get(webhook.HeaderSignature)) {
+		rc.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var p map[string]interface{}
+	json.Unmarshal(body, &p)
+	rc.payloads = append(rc.payloads, p)
+
+	w.WriteHeader(rc.status)
+	w.Write([]byte("ok"))
+}
+
+func (rc *receiver) received() []map[string]interface{} {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]map[string]interface{}{}, rc.payloads...)
+}
+
+func TestWebhooks(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	adminUser := model.User{
+		Username: "admin",
+		Email:    "admin@example.com",
+		Password: "secret",
+		Admin:    true,
+	}
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&adminUser, &fooUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	adminToken, err := auth.GenerateToken(adminUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminCtx := ctxWithToken(context.Background(), adminToken)
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	ok := &receiver{status: http.StatusOK}
+	okServer := httptest.NewServer(ok)
+	defer okServer.Close()
+
+	ng := &receiver{status: http.StatusInternalServerError}
+	ngServer := httptest.NewServer(ng)
+	defer ngServer.Close()
+
+	tests := []struct {
+		title    string
+		ctx      context.Context
+		req      *pb.CreateWebhookRequest_Webhook
+		hasError bool
+	}{
+		{
+			"create webhook: success",
+			adminCtx,
+			&pb.CreateWebhookRequest_Webhook{
+				Url:    okServer.URL,
+				Secret: webhookSecret,
+				Events: []string{model.EventArticleCreated, model.EventCommentCreated},
+				Active: true,
+			},
+			false,
+		},
+		{
+			"create webhook: failing receiver",
+			adminCtx,
+			&pb.CreateWebhookRequest_Webhook{
+				Url:    ngServer.URL,
+				Secret: webhookSecret,
+				Events: []string{model.EventArticleCreated},
+				Active: true,
+			},
+			false,
+		},
+		{
+			"create webhook: not admin",
+			fooCtx,
+			&pb.CreateWebhookRequest_Webhook{
+				Url:    okServer.URL,
+				Secret: webhookSecret,
+				Events: []string{model.EventArticleCreated},
+				Active: true,
+			},
+			true,
+		},
+		{
+			"create webhook: unknown event",
+			adminCtx,
+			&pb.CreateWebhookRequest_Webhook{
+				Url:    okServer.URL,
+				Secret: webhookSecret,
+				Events: []string{"user.created"},
+				Active: true,
+			},
+			true,
+		},
+		{
+			"create webhook: short secret",
+			adminCtx,
+			&pb.CreateWebhookRequest_Webhook{
+				Url:    okServer.URL,
+				Secret: "secret",
+				Events: []string{model.EventArticleCreated},
+				Active: true,
+			},
+			true,
+		},
+		{
+			"create webhook: invalid url",
+			adminCtx,
+			&pb.CreateWebhookRequest_Webhook{
+				Url:    "not a url",
+				Secret: webhookSecret,
+				Events: []string{model.EventArticleCreated},
+				Active: true,
+			},
+			true,
+		},
+	}
+
+	var hooks []*pb.Webhook
+	for _, tt := range tests {
+		resp, err := h.CreateWebhook(tt.ctx, &pb.CreateWebhookRequest{Webhook: tt.req})
+		if tt.hasError {
+			if err == nil {
+				t.Errorf("%q expected to fail, but succeeded.", tt.title)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q expected to succeed, but failed. %v", tt.title, err)
+			continue
+		}
+
+		assert.Equal(t, tt.req.GetUrl(), resp.GetWebhook().GetUrl())
+		assert.Equal(t, tt.req.GetEvents(), resp.GetWebhook().GetEvents())
+		hooks = append(hooks, resp.GetWebhook())
+	}
+	if !assert.Len(t, hooks, 2) {
+		t.FailNow()
+	}
+	okHook, ngHook := hooks[0], hooks[1]
+
+	list, err := h.ListWebhooks(adminCtx, &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.GetWebhooks(), 2)
+	}
+	_, err = h.ListWebhooks(fooCtx, &pb.Empty{})
+	assert.Error(t, err, "only admin can list webhooks")
+
+	// trigger events
+	resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	slug := resp.GetArticle().GetSlug()
+
+	_, err = h.CreateComment(fooCtx, &pb.CreateCommentRequest{
+		Slug:    slug,
+		Comment: &pb.CreateCommentRequest_Comment{Body: "comment"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+
+	// not subscribed
+	_, err = h.FavoriteArticle(fooCtx, &pb.FavoriteArticleRequest{Slug: slug})
+	if err != nil {
+		t.Fatalf("failed to favorite article: %v", err)
+	}
+
+	// whole seconds as DATETIME columns keep
+	now := time.Now().Truncate(time.Second)
+	if err := h.DeliverWebhooks(now); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+
+	got := ok.received()
+	if !assert.Len(t, got, 2) {
+		t.FailNow()
+	}
+	assert.Equal(t, 0, ok.invalid)
+	assert.Equal(t, model.EventArticleCreated, got[0]["event"])
+	assert.Equal(t, model.EventCommentCreated, got[1]["event"])
+	data := got[1]["data"].(map[string]interface{})
+	assert.Equal(t, "title", data["article"].(map[string]interface{})["title"])
+	assert.Equal(t, "comment", data["comment"].(map[string]interface{})["body"])
+
+	ds, err := h.ListWebhookDeliveries(adminCtx, &pb.ListWebhookDeliveriesRequest{WebhookId: okHook.GetId()})
+	if assert.NoError(t, err) && assert.Len(t, ds.GetDeliveries(), 2) {
+		for _, d := range ds.GetDeliveries() {
+			assert.Equal(t, model.DeliverySucceeded, d.GetStatus())
+			assert.Equal(t, int32(1), d.GetAttempts())
+			assert.Equal(t, int32(http.StatusOK), d.GetResponseStatus())
+			assert.NotEmpty(t, d.GetDeliveredAt())
+		}
+	}
+
+	// failed deliveries are retried with backoff
+	ds, err = h.ListWebhookDeliveries(adminCtx, &pb.ListWebhookDeliveriesRequest{WebhookId: ngHook.GetId()})
+	if !assert.NoError(t, err) || !assert.Len(t, ds.GetDeliveries(), 1) {
+		t.FailNow()
+	}
+	failed := ds.GetDeliveries()[0]
+	assert.Equal(t, model.DeliveryPending, failed.GetStatus())
+	assert.Equal(t, int32(1), failed.GetAttempts())
+	assert.Equal(t, int32(http.StatusInternalServerError), failed.GetResponseStatus())
+	assert.NotEmpty(t, failed.GetError())
+
+	if err := h.DeliverWebhooks(now.Add(time.Second)); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+	assert.Len(t, ng.received(), 1, "not retried before backoff")
+
+	at := now
+	for i := 1; i < webhook.MaxAttempts; i++ {
+		at = at.Add(webhook.Backoff(i))
+		if err := h.DeliverWebhooks(at); err != nil {
+			t.Fatalf("failed to deliver webhooks: %v", err)
+		}
+	}
+	assert.Len(t, ng.received(), webhook.MaxAttempts)
+
+	d, err := h.GetWebhookDelivery(adminCtx, &pb.GetWebhookDeliveryRequest{WebhookId: ngHook.GetId(), Id: failed.GetId()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, model.DeliveryFailed, d.GetDelivery().GetStatus())
+		assert.Equal(t, int32(webhook.MaxAttempts), d.GetDelivery().GetAttempts())
+	}
+
+	_, err = h.GetWebhookDelivery(adminCtx, &pb.GetWebhookDeliveryRequest{WebhookId: okHook.GetId(), Id: failed.GetId()})
+	assert.Error(t, err, "delivery of other webhook")
+
+	// replay
+	_, err = h.ReplayWebhookDelivery(fooCtx, &pb.GetWebhookDeliveryRequest{WebhookId: ngHook.GetId(), Id: failed.GetId()})
+	assert.Error(t, err, "only admin can replay deliveries")
+
+	ng.mu.Lock()
+	ng.status = http.StatusNoContent
+	ng.mu.Unlock()
+
+	replay, err := h.ReplayWebhookDelivery(adminCtx, &pb.GetWebhookDeliveryRequest{WebhookId: ngHook.GetId(), Id: failed.GetId()})
+	if err != nil {
+		t.Fatalf("failed to replay delivery: %v", err)
+	}
+	assert.NotEqual(t, failed.GetId(), replay.GetDelivery().GetId())
+	assert.Equal(t, failed.GetPayload(), replay.GetDelivery().GetPayload())
+
+	if err := h.DeliverWebhooks(time.Now()); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+	d, err = h.GetWebhookDelivery(adminCtx, &pb.GetWebhookDeliveryRequest{WebhookId: ngHook.GetId(), Id: replay.GetDelivery().GetId()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, model.DeliverySucceeded, d.GetDelivery().GetStatus())
+	}
+
+	// inactive webhooks receive nothing
+	_, err = h.UpdateWebhook(adminCtx, &pb.UpdateWebhookRequest{
+		Webhook: &pb.UpdateWebhookRequest_Webhook{
+			Id:     okHook.GetId(),
+			Url:    okServer.URL,
+			Events: okHook.GetEvents(),
+			Active: false,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to update webhook: %v", err)
+	}
+
+	_, err = h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:       "other title",
+			Description: "description",
+			Body:        "body",
+			TagList:     []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	if err := h.DeliverWebhooks(time.Now()); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+	assert.Len(t, ok.received(), 2)
+
+	if _, err := h.DeleteWebhook(adminCtx, &pb.DeleteWebhookRequest{Id: okHook.GetId()}); err != nil {
+		t.Fatalf("failed to delete webhook: %v", err)
+	}
+	list, err = h.ListWebhooks(adminCtx, &pb.Empty{})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.GetWebhooks(), 1)
+	}
+}
+
+func TestDraftWebhooks(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	adminUser := model.User{
+		Username: "admin",
+		Email:    "admin@example.com",
+		Password: "secret",
+		Admin:    true,
+	}
+
+	fooUser := model.User{
+		Username: "foo",
+		Email:    "foo@example.com",
+		Password: "secret",
+	}
+
+	for _, u := range []*model.User{&adminUser, &fooUser} {
+		if err := h.us.Create(u); err != nil {
+			t.Fatalf("failed to create initial user record: %v", err)
+		}
+	}
+
+	adminToken, err := auth.GenerateToken(adminUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminCtx := ctxWithToken(context.Background(), adminToken)
+
+	fooToken, err := auth.GenerateToken(fooUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fooCtx := ctxWithToken(context.Background(), fooToken)
+
+	rc := &receiver{status: http.StatusOK}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	_, err = h.CreateWebhook(adminCtx, &pb.CreateWebhookRequest{
+		Webhook: &pb.CreateWebhookRequest_Webhook{
+			Url:    server.URL,
+			Secret: webhookSecret,
+			Events: []string{model.EventArticleCreated, model.EventArticleUpdated, model.EventArticleDeleted},
+			Active: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+
+	// drafts and scheduled articles are not announced
+	publishAt := time.Now().Add(time.Hour)
+	var slugs []string
+	for _, a := range []*pb.CreateAritcleRequest_Article{
+		{Title: "draft", Body: "draft", TagList: []string{"foo"}, Status: model.ArticleStatusDraft},
+		{Title: "scheduled", Body: "scheduled", TagList: []string{"foo"}, Status: model.ArticleStatusScheduled, PublishAt: publishAt.Format(time.RFC3339)},
+		{Title: "deleted draft", Body: "deleted draft", TagList: []string{"foo"}, Status: model.ArticleStatusDraft},
+	} {
+		resp, err := h.CreateArticle(fooCtx, &pb.CreateAritcleRequest{Article: a})
+		if err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		slugs = append(slugs, resp.GetArticle().GetSlug())
+	}
+
+	_, err = h.UpdateArticle(fooCtx, &pb.UpdateArticleRequest{
+		Article: &pb.UpdateArticleRequest_Article{Slug: slugs[0], Body: "updated draft"},
+	})
+	if err != nil {
+		t.Fatalf("failed to update draft: %v", err)
+	}
+	if _, err := h.DeleteArticle(fooCtx, &pb.DeleteArticleRequest{Slug: slugs[2]}); err != nil {
+		t.Fatalf("failed to delete draft: %v", err)
+	}
+
+	// whole seconds as DATETIME columns keep
+	now := time.Now().Truncate(time.Second)
+	if err := h.DeliverWebhooks(now); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+	assert.Empty(t, rc.received())
+
+	// articles are created when they are published
+	if _, err := h.PublishArticle(fooCtx, &pb.PublishArticleRequest{Slug: slugs[0]}); err != nil {
+		t.Fatalf("failed to publish draft: %v", err)
+	}
+	if err := h.PublishScheduledArticles(publishAt.Add(time.Minute)); err != nil {
+		t.Fatalf("failed to publish scheduled articles: %v", err)
+	}
+	if err := h.DeliverWebhooks(now.Add(time.Second)); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+
+	got := rc.received()
+	if assert.Len(t, got, 2) {
+		titles := []string{}
+		for _, p := range got {
+			assert.Equal(t, model.EventArticleCreated, p["event"])
+			titles = append(titles, p["data"].(map[string]interface{})["article"].(map[string]interface{})["title"].(string))
+		}
+		assert.ElementsMatch(t, []string{"draft", "scheduled"}, titles)
+	}
+}
+
+func TestConcurrentWebhookDeliveries(t *testing.T) {
+	h, cleaner := setUp(t)
+	defer cleaner(t)
+
+	adminUser := model.User{
+		Username: "admin",
+		Email:    "admin@example.com",
+		Password: "secret",
+		Admin:    true,
+	}
+	if err := h.us.Create(&adminUser); err != nil {
+		t.Fatalf("failed to create initial user record: %v", err)
+	}
+
+	adminToken, err := auth.GenerateToken(adminUser.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminCtx := ctxWithToken(context.Background(), adminToken)
+
+	fast := &receiver{status: http.StatusOK}
+	fastServer := httptest.NewServer(fast)
+	defer fastServer.Close()
+
+	// the slow receiver answers once released
+	slow := &receiver{status: http.StatusOK}
+	arrived, release := make(chan struct{}, 1), make(chan struct{})
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		slow.ServeHTTP(w, r)
+	}))
+	defer slowServer.Close()
+
+	for _, url := range []string{slowServer.URL, fastServer.URL} {
+		_, err := h.CreateWebhook(adminCtx, &pb.CreateWebhookRequest{
+			Webhook: &pb.CreateWebhookRequest_Webhook{
+				Url:    url,
+				Secret: webhookSecret,
+				Events: []string{model.EventArticleCreated},
+				Active: true,
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to create webhook: %v", err)
+		}
+	}
+
+	_, err = h.CreateArticle(adminCtx, &pb.CreateAritcleRequest{
+		Article: &pb.CreateAritcleRequest_Article{
+			Title:   "title",
+			Body:    "body",
+			TagList: []string{"foo"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+
+	// whole seconds as DATETIME columns keep
+	now := time.Now().Truncate(time.Second)
+	done := make(chan error, 1)
+	go func() { done <- h.DeliverWebhooks(now) }()
+
+	select {
+	case <-arrived:
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow receiver got nothing")
+	}
+
+	// the fast receiver is not held up by the slow one
+	assert.Eventually(t, func() bool { return len(fast.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// another server does not send claimed deliveries again
+	if err := h.DeliverWebhooks(now); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+
+	assert.Len(t, fast.received(), 1)
+	assert.Len(t, slow.received(), 1)
+}
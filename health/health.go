@@ -0,0 +1,130 @@
+// Package health reports whether the server is ready to serve, through the
+// gRPC health checking protocol and HTTP probes of the gateway
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// pingTimeout is the timeout of a database ping
+const pingTimeout = 2 * time.Second
+
+// probeTimeout is the timeout of a health check of the backend from the gateway
+const probeTimeout = 2 * time.Second
+
+// Checker sets serving statuses of the server and its services from the
+// migration status and pings of the database
+type Checker struct {
+	hs       *health.Server
+	db       *sql.DB
+	migrated int32
+
+	mu       sync.Mutex
+	services []string
+	status   healthpb.HealthCheckResponse_ServingStatus
+}
+
+// NewChecker returns a new Checker. The server and its services are not
+// serving until the database is migrated and answers a ping.
+func NewChecker(db *sql.DB) *Checker {
+	c := &Checker{
+		hs: health.NewServer(),
+		db: db,
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Register registers the health service to the server, and reports
+// statuses of the services registered to it so far
+func (c *Checker) Register(s *grpc.Server) {
+	healthpb.RegisterHealthServer(s, c.hs)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name := range s.GetServiceInfo() {
+		if name == healthpb.Health_ServiceDesc.ServiceName {
+			continue
+		}
+		c.services = append(c.services, name)
+		c.hs.SetServingStatus(name, c.status)
+	}
+}
+
+// SetMigrated records that the database schema is migrated
+func (c *Checker) SetMigrated() {
+	atomic.StoreInt32(&c.migrated, 1)
+}
+
+// Check pings the database and updates the serving statuses
+func (c *Checker) Check() error {
+	err := c.check()
+	if err != nil {
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		return err
+	}
+
+	c.setStatus(healthpb.HealthCheckResponse_SERVING)
+	return nil
+}
+
+func (c *Checker) check() error {
+	if atomic.LoadInt32(&c.migrated) == 0 {
+		return fmt.Errorf("database is not migrated")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+	if err := c.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+	return nil
+}
+
+func (c *Checker) setStatus(s healthpb.HealthCheckResponse_ServingStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.status = s
+	c.hs.SetServingStatus("", s)
+	for _, name := range c.services {
+		c.hs.SetServingStatus(name, s)
+	}
+}
+
+// LivenessHandler answers 200 OK as long as the process serves HTTP
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok\n"))
+	})
+}
+
+// ReadinessHandler answers 200 OK if the backend reports that it is serving,
+// and 503 Service Unavailable otherwise
+func ReadinessHandler(client healthpb.HealthClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), probeTimeout)
+		defer cancel()
+
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("backend unavailable: %v", err), http.StatusServiceUnavailable)
+			return
+		}
+		if s := resp.GetStatus(); s != healthpb.HealthCheckResponse_SERVING {
+			http.Error(w, fmt.Sprintf("backend not serving: %s", s), http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n"))
+	})
+}
@@ -0,0 +1,85 @@
+package health
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/raahii/golang-grpc-realworld-example/db"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestHealth(t *testing.T) {
+	d, err := db.NewTestDB()
+	if err != nil {
+		t.Fatalf("failed to initialize database: %v", err)
+	}
+	defer db.DropTestDB(d)
+
+	hc := NewChecker(d.DB())
+	s := grpc.NewServer()
+	pb.RegisterUsersServer(s, &pb.UnimplementedUsersServer{})
+	hc.Register(s)
+
+	lis := bufconn.Listen(1024 * 1024)
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+	ready := ReadinessHandler(client)
+
+	assertStatus := func(title string, want healthpb.HealthCheckResponse_ServingStatus, code int) {
+		for _, service := range []string{"", "user.Users"} {
+			resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+			if err != nil {
+				t.Fatalf("%q: failed to check health of %q: %v", title, service, err)
+			}
+			assert.Equal(t, want, resp.GetStatus(), "%s: %q", title, service)
+		}
+
+		w := httptest.NewRecorder()
+		ready.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		assert.Equal(t, code, w.Code, title)
+	}
+
+	// not ready until the database is migrated
+	assert.Error(t, hc.Check())
+	assertStatus("before migration", healthpb.HealthCheckResponse_NOT_SERVING, http.StatusServiceUnavailable)
+
+	hc.SetMigrated()
+	assert.NoError(t, hc.Check())
+	assertStatus("after migration", healthpb.HealthCheckResponse_SERVING, http.StatusOK)
+
+	// not ready when the database is down
+	db.DropTestDB(d)
+	assert.Error(t, hc.Check())
+	assertStatus("database closed", healthpb.HealthCheckResponse_NOT_SERVING, http.StatusServiceUnavailable)
+
+	// not ready when the backend is down
+	s.Stop()
+	w := httptest.NewRecorder()
+	ready.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = httptest.NewRecorder()
+	LivenessHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
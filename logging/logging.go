@@ -0,0 +1,142 @@
+// Package logging logs RPCs with request IDs, and binds loggers to their contexts
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/raahii/golang-grpc-realworld-example/auth"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDKey is the metadata key of request IDs, which is X-Request-Id in HTTP
+const RequestIDKey = "x-request-id"
+
+// maxRequestIDLength is the maximum length of request IDs given by clients
+const maxRequestIDLength = 64
+
+type loggerKey struct{}
+
+// NewContext returns the context bound to the logger
+func NewContext(ctx context.Context, l *zerolog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// Ctx returns the logger bound to the context, or the fallback if no logger is bound
+func Ctx(ctx context.Context, fallback *zerolog.Logger) *zerolog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*zerolog.Logger); ok {
+		return l
+	}
+	return fallback
+}
+
+// RequestID returns the request ID given by the client, or a new one if there is none
+func RequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDKey); len(ids) > 0 && validRequestID(ids[0]) {
+			return ids[0]
+		}
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// validRequestID reports whether the request ID is short and printable
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// UnaryServerInterceptor logs unary RPCs with their redacted requests,
+// and binds the request-scoped logger to the contexts of the handlers
+func UnaryServerInterceptor(l *zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		rl, id := requestLogger(ctx, l, info.FullMethod)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id))
+
+		resp, err := handler(NewContext(ctx, rl), req)
+
+		e := event(rl, info.FullMethod, err)
+		if m, ok := req.(proto.Message); ok {
+			e = e.RawJSON("req", Redact(m))
+		}
+		e.Str("code", status.Code(err).String()).
+			Dur("duration", time.Since(start)).
+			Msg("handled request")
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor logs streaming RPCs, and binds the request-scoped
+// logger to the contexts of the handlers. Messages of streams are not logged.
+func StreamServerInterceptor(l *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		rl, id := requestLogger(ss.Context(), l, info.FullMethod)
+		ss.SetHeader(metadata.Pairs(RequestIDKey, id))
+
+		ws := grpc_middleware.WrapServerStream(ss)
+		ws.WrappedContext = NewContext(ss.Context(), rl)
+		err := handler(srv, ws)
+
+		event(rl, info.FullMethod, err).
+			Str("code", status.Code(err).String()).
+			Dur("duration", time.Since(start)).
+			Msg("handled stream")
+		return err
+	}
+}
+
+// requestLogger returns the logger of the request and its request ID
+func requestLogger(ctx context.Context, l *zerolog.Logger, method string) (*zerolog.Logger, string) {
+	id := RequestID(ctx)
+	c := l.With().Str("request_id", id).Str("method", method)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		c = c.Str("trace_id", sc.TraceID().String())
+	}
+	if userID, err := auth.GetUserID(ctx); err == nil {
+		c = c.Uint("user_id", userID)
+	}
+
+	rl := c.Logger()
+	return &rl, id
+}
+
+// event returns the log event of the result of the RPC. Failures of the
+// server are errors, and ones of clients are warnings. Successful health
+// checks are debug logs, as probes of orchestrators call them frequently.
+func event(l *zerolog.Logger, method string, err error) *zerolog.Event {
+	switch status.Code(err) {
+	case codes.OK:
+		if method == healthpb.Health_Check_FullMethodName {
+			return l.Debug()
+		}
+		return l.Info()
+	case codes.Unknown, codes.Internal, codes.Aborted, codes.DataLoss, codes.Unavailable:
+		return l.Error().Err(err)
+	default:
+		return l.Warn().Err(err)
+	}
+}
@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRedact(t *testing.T) {
+	for _, tt := range []struct {
+		title string
+		req   proto.Message
+		want  string
+	}{
+		{
+			"login",
+			&pb.LoginUserRequest{User: &pb.LoginUserRequest_User{Email: "foo@example.com", Password: "secret"}},
+			`{"user":{"email":"foo@example.com","password":"[REDACTED]"}}`,
+		},
+		{
+			"webhook secret",
+			&pb.CreateWebhookRequest{Webhook: &pb.CreateWebhookRequest_Webhook{Url: "http://example.com", Secret: "secret"}},
+			`{"webhook":{"url":"http://example.com","secret":"[REDACTED]"}}`,
+		},
+		{
+			"empty password is left out",
+			&pb.UpdateUserRequest{User: &pb.UpdateUserRequest_User{Bio: "bio"}},
+			`{"user":{"bio":"bio"}}`,
+		},
+	} {
+		got := Redact(tt.req)
+		assert.JSONEq(t, tt.want, string(got), tt.title)
+	}
+
+	// the request itself is left as is
+	req := &pb.LoginUserRequest{User: &pb.LoginUserRequest_User{Password: "secret"}}
+	Redact(req)
+	assert.Equal(t, "secret", req.GetUser().GetPassword())
+}
+
+func TestRequestID(t *testing.T) {
+	for _, tt := range []struct {
+		title string
+		id    string
+		given bool
+	}{
+		{"given", "req-1", true},
+		{"not given", "", false},
+		{"not printable", "bad id", false},
+		{"too long", strings.Repeat("a", maxRequestIDLength+1), false},
+	} {
+		ctx := context.Background()
+		if tt.id != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(RequestIDKey, tt.id))
+		}
+
+		got := RequestID(ctx)
+		if tt.given {
+			assert.Equal(t, tt.id, got, tt.title)
+		} else {
+			assert.Len(t, got, 32, tt.title)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	for _, tt := range []struct {
+		title  string
+		method string
+		err    error
+		level  string
+		code   string
+	}{
+		{"success", "/user.Users/LoginUser", nil, "info", "OK"},
+		{"client error", "/user.Users/LoginUser", status.Error(codes.InvalidArgument, "invalid password"), "warn", "InvalidArgument"},
+		{"server error", "/user.Users/LoginUser", status.Error(codes.Aborted, "internal server error"), "error", "Aborted"},
+		{"health check", healthpb.Health_Check_FullMethodName, nil, "debug", "OK"},
+	} {
+		var buf bytes.Buffer
+		l := zerolog.New(&buf)
+		interceptor := UnaryServerInterceptor(&l)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDKey, "req-1"))
+		req := &pb.LoginUserRequest{User: &pb.LoginUserRequest_User{Email: "foo@example.com", Password: "hunter2"}}
+		info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+		_, err := interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			// handlers log with the logger of the request
+			Ctx(ctx, nil).Info().Msg("handling")
+			return nil, tt.err
+		})
+		assert.Equal(t, tt.err, err, tt.title)
+
+		assert.NotContains(t, buf.String(), "hunter2", tt.title)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if !assert.Len(t, lines, 2, tt.title) {
+			continue
+		}
+		for i, line := range lines {
+			var entry map[string]interface{}
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				t.Fatalf("%q: failed to parse log: %v", tt.title, err)
+			}
+			assert.Equal(t, "req-1", entry["request_id"], tt.title)
+			assert.Equal(t, tt.method, entry["method"], tt.title)
+
+			if i == len(lines)-1 {
+				assert.Equal(t, tt.level, entry["level"], tt.title)
+				assert.Equal(t, tt.code, entry["code"], tt.title)
+				assert.Contains(t, entry, "duration", tt.title)
+				assert.Contains(t, entry, "req", tt.title)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redacted replaces values of sensitive fields in logs
+const redacted = "[REDACTED]"
+
+// sensitiveFields are names of the fields in requests whose values must not be logged
+var sensitiveFields = map[protoreflect.Name]bool{
+	"password": true,
+	"token":    true,
+	"secret":   true,
+}
+
+// Redact returns the message as JSON, with values of sensitive fields replaced
+func Redact(m proto.Message) json.RawMessage {
+	c := protov2.Clone(proto.MessageV2(m))
+	redact(c.ProtoReflect())
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(c)
+	if err != nil {
+		return json.RawMessage(`null`)
+	}
+	return b
+}
+
+func redact(m protoreflect.Message) {
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case sensitiveFields[fd.Name()] && fd.Kind() == protoreflect.StringKind && !fd.IsList():
+			// the message is not modified while ranging over it
+			fields = append(fields, fd)
+		case fd.IsList() && fd.Message() != nil:
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				redact(l.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redact(mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			redact(v.Message())
+		}
+		return true
+	})
+
+	for _, fd := range fields {
+		m.Set(fd, protoreflect.ValueOfString(redacted))
+	}
+}
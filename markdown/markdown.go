@@ -0,0 +1,62 @@
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+)
+
+// wordsPerMinute is an average reading speed used to estimate reading time
+const wordsPerMinute = 200
+
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+
+	policy = newPolicy()
+
+	textPolicy = bluemonday.StrictPolicy()
+)
+
+// newPolicy returns a sanitizer policy for user generated contents,
+// keeping heading anchors and the language of fenced code blocks
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("id").
+		Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).
+		OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("class").
+		Matching(regexp.MustCompile(`^language-[a-zA-Z0-9+#_-]+$`)).
+		OnElements("code")
+	p.RequireNoReferrerOnLinks(true)
+	return p
+}
+
+// Render converts markdown into sanitized HTML
+func Render(src string) (string, error) {
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(src), &buf); err != nil {
+		return "", err
+	}
+	return policy.Sanitize(buf.String()), nil
+}
+
+// WordCount counts words in the rendered HTML
+func WordCount(html string) int {
+	return len(strings.Fields(textPolicy.Sanitize(html)))
+}
+
+// ReadingTime estimates minutes to read the given number of words
+func ReadingTime(words int) int {
+	if words == 0 {
+		return 0
+	}
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
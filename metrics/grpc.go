@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handled_total",
+		Help:      "Number of RPCs completed on the server by method and status code.",
+	}, []string{"grpc_type", "grpc_method", "grpc_code"})
+
+	grpcHandling = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handling_seconds",
+		Help:      "Latency of RPCs handled on the server by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"grpc_type", "grpc_method"})
+)
+
+// UnaryServerInterceptor records counts and latencies of unary RPCs
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRPC("unary", info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamServerInterceptor records counts and durations of streaming RPCs
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observeRPC("stream", info.FullMethod, start, err)
+	return err
+}
+
+func observeRPC(typ, method string, start time.Time, err error) {
+	grpcHandled.WithLabelValues(typ, method, status.Code(err).String()).Inc()
+	grpcHandling.WithLabelValues(typ, method).Observe(time.Since(start).Seconds())
+}
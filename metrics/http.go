@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests completed by route, method and status code.",
+	}, []string{"route", "method", "code"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests by route and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method"})
+)
+
+// routeLiterals are literal path segments of the HTTP routes. Other segments
+// are parameters, which are replaced in route labels to bound their cardinality.
+var routeLiterals = map[string]bool{
+	"admin": true, "articles": true, "block": true, "blocks": true, "bookmark": true,
+	"bookmarks": true, "comments": true, "deliveries": true, "delete": true, "drafts": true,
+	"export": true, "favorite": true, "feed": true, "follow": true, "followers": true,
+	"following": true, "images": true, "login": true, "moderation": true, "mute": true,
+	"mutes": true, "notifications": true, "profiles": true, "publish": true, "queue": true,
+	"reactions": true, "read": true, "replay": true, "reports": true, "resolve": true,
+	"restore": true, "revisions": true, "tags": true, "trash": true, "unread": true,
+	"user": true, "users": true, "watch": true, "webhooks": true,
+}
+
+// Route returns the route of the path, with parameters replaced with ":param"
+func Route(path string) string {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range segs {
+		if s != "" && !routeLiterals[s] {
+			segs[i] = ":param"
+		}
+	}
+	return "/" + strings.Join(segs, "/")
+}
+
+// Middleware records counts and latencies of HTTP requests
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rw := &responseWriter{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(rw, r)
+
+		route := Route(r.URL.Path)
+		httpRequests.WithLabelValues(route, r.Method, strconv.Itoa(rw.code)).Inc()
+		httpDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
+
+// responseWriter records the status code of the response
+type responseWriter struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.code = code
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush keeps streaming responses working through the writer
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.wroteHeader = true
+		f.Flush()
+	}
+}
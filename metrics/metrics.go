@@ -0,0 +1,72 @@
+// Package metrics records Prometheus metrics of the server and the gateway
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/raahii/golang-grpc-realworld-example/cache"
+)
+
+const namespace = "realworld"
+
+// Business counters
+var (
+	Signups = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "signups_total",
+		Help:      "Number of users signed up.",
+	})
+
+	ArticlesCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "articles_created_total",
+		Help:      "Number of articles created, including drafts.",
+	})
+
+	CommentsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "comments_created_total",
+		Help:      "Number of comments created.",
+	})
+)
+
+// Handler returns the handler of the /metrics endpoint
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RegisterDB exports the connection pool stats of the database
+func RegisterDB(db *sql.DB, name string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterCache exports the lookup counters of the cache
+func RegisterCache(c *cache.Cache) error {
+	for _, m := range []struct {
+		name string
+		help string
+		get  func(cache.Stats) uint64
+	}{
+		{"cache_hits_total", "Number of cache lookups served from the cache.", func(s cache.Stats) uint64 { return s.Hits }},
+		{"cache_misses_total", "Number of cache lookups loaded from the database.", func(s cache.Stats) uint64 { return s.Misses }},
+		{"cache_errors_total", "Number of cache backend failures.", func(s cache.Stats) uint64 { return s.Errors }},
+	} {
+		get := m.get
+		err := prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      m.name,
+			Help:      m.help,
+		}, func() float64 {
+			return float64(get(c.Stats()))
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoute(t *testing.T) {
+	for _, tt := range []struct {
+		path string
+		want string
+	}{
+		{"/", "/"},
+		{"/articles", "/articles"},
+		{"/articles/", "/articles"},
+		{"/articles/42", "/articles/:param"},
+		{"/articles/42/comments/7", "/articles/:param/comments/:param"},
+		{"/profiles/foo/follow", "/profiles/:param/follow"},
+		{"/images/abc.png", "/images/:param"},
+	} {
+		assert.Equal(t, tt.want, Route(tt.path), tt.path)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte("ok"))
+	}))
+
+	for _, tt := range []struct {
+		method string
+		code   string
+	}{
+		{http.MethodGet, "200"},
+		{http.MethodDelete, "404"},
+	} {
+		c := httpRequests.WithLabelValues("/articles/:param", tt.method, tt.code)
+		before := testutil.ToFloat64(c)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(tt.method, "/articles/42", nil))
+
+		assert.Equal(t, before+1, testutil.ToFloat64(c), tt.method)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/article.Articles/GetArticle"}
+
+	for _, tt := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{nil, codes.OK},
+		{status.Error(codes.NotFound, "not found"), codes.NotFound},
+	} {
+		c := grpcHandled.WithLabelValues("unary", info.FullMethod, tt.code.String())
+		before := testutil.ToFloat64(c)
+
+		_, err := UnaryServerInterceptor(context.Background(), nil, info,
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tt.err
+			})
+
+		assert.Equal(t, tt.err, err, tt.code)
+		assert.Equal(t, before+1, testutil.ToFloat64(c), tt.code)
+	}
+}
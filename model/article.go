@@ -2,14 +2,23 @@ package model
 
 import (
 	"fmt"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/markdown"
 	pb "github.com/raahii/golang-grpc-realworld-example/proto"
 )
 
 const ISO8601 = "2006-01-02T15:04:05-0700Z"
 
+// Article publication statuses
+const (
+	ArticleStatusDraft     = "draft"
+	ArticleStatusScheduled = "scheduled"
+	ArticleStatusPublished = "published"
+)
+
 // Article model
 type Article struct {
 	gorm.Model
@@ -22,10 +31,22 @@ type Article struct {
 	FavoritesCount int32  `gorm:"not null;default=0"`
 	FavoritedUsers []User `gorm:"many2many:favorite_articles"`
 	Comments       []Comment
+	Status         string `gorm:"not null;default:'published';index"`
+	PublishAt      *time.Time
+	BodyHTML       string     `gorm:"type:text"`
+	WordCount      int32      `gorm:"not null;default:0"`
+	ReadingTime    int32      `gorm:"not null;default:0"`
+	HiddenAt       *time.Time `gorm:"index"`
 }
 
 // Validate validates fields of article model
 func (a Article) Validate() error {
+	// scheduled articles must know when to be published
+	publishAtRules := []validation.Rule{}
+	if a.Status == ArticleStatusScheduled {
+		publishAtRules = append(publishAtRules, validation.Required)
+	}
+
 	return validation.ValidateStruct(&a,
 		validation.Field(
 			&a.Title,
@@ -39,9 +60,48 @@ func (a Article) Validate() error {
 			&a.Tags,
 			validation.Required,
 		),
+		validation.Field(
+			&a.Status,
+			validation.In(ArticleStatusDraft, ArticleStatusScheduled, ArticleStatusPublished),
+		),
+		validation.Field(
+			&a.PublishAt,
+			publishAtRules...,
+		),
 	)
 }
 
+// IsPublished returns whether the article is visible to everyone
+func (a *Article) IsPublished() bool {
+	return a.Status == "" || a.Status == ArticleStatusPublished
+}
+
+// IsHidden returns whether the article is hidden by moderation
+func (a *Article) IsHidden() bool {
+	return a.HiddenAt != nil
+}
+
+// VisibleTo returns whether the user can read the article.
+// Drafts, scheduled and hidden articles are visible only to their author.
+func (a *Article) VisibleTo(u *User) bool {
+	if a.IsPublished() && !a.IsHidden() {
+		return true
+	}
+	return u != nil && u.ID == a.UserID
+}
+
+// Publish makes the article public at the given time
+func (a *Article) Publish(t time.Time) {
+	a.Status = ArticleStatusPublished
+	a.PublishAt = &t
+}
+
+// Schedule makes the article to be published at the given time
+func (a *Article) Schedule(t time.Time) {
+	a.Status = ArticleStatusScheduled
+	a.PublishAt = &t
+}
+
 // Overwrite overwrite each field if it's not zero-value
 func (a *Article) Overwrite(title, description, body string) {
 	if title != "" {
@@ -57,6 +117,26 @@ func (a *Article) Overwrite(title, description, body string) {
 	}
 }
 
+// RenderBody renders the markdown body into sanitized HTML and counts its words
+func (a *Article) RenderBody() error {
+	html, err := markdown.Render(a.Body)
+	if err != nil {
+		return err
+	}
+
+	words := markdown.WordCount(html)
+	a.BodyHTML = html
+	a.WordCount = int32(words)
+	a.ReadingTime = int32(markdown.ReadingTime(words))
+
+	return nil
+}
+
+// Text returns the article contents as a single document
+func (a *Article) Text() string {
+	return contentText(a.Title, a.Description, a.Body)
+}
+
 // ProtoArticle generates proto aritcle model from article
 func (a *Article) ProtoArticle(favorited bool) *pb.Article {
 	pa := pb.Article{
@@ -68,6 +148,18 @@ func (a *Article) ProtoArticle(favorited bool) *pb.Article {
 		Favorited:      favorited,
 		CreatedAt:      a.CreatedAt.Format(ISO8601),
 		UpdatedAt:      a.UpdatedAt.Format(ISO8601),
+		Status:         a.Status,
+		BodyHtml:       a.BodyHTML,
+		WordCount:      a.WordCount,
+		ReadingTime:    a.ReadingTime,
+	}
+
+	if a.PublishAt != nil {
+		pa.PublishAt = a.PublishAt.Format(ISO8601)
+	}
+
+	if a.DeletedAt != nil {
+		pa.DeletedAt = a.DeletedAt.Format(ISO8601)
 	}
 
 	// article tags
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// Bookmark model keeps an article in a user's private reading list
+type Bookmark struct {
+	ID        uint `gorm:"primary_key"`
+	UserID    uint `gorm:"not null;unique_index:idx_bookmarks_user_article"`
+	ArticleID uint `gorm:"not null;unique_index:idx_bookmarks_user_article;index"`
+	CreatedAt time.Time
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/jinzhu/gorm"
@@ -16,6 +17,9 @@ type Comment struct {
 	Author    User   `gorm:"foreignkey:UserID"`
 	ArticleID uint   `gorm:"not null"`
 	Article   Article
+	HiddenAt  *time.Time `gorm:"index"`
+	// DeletedWithArticle is set when the comment is deleted together with its article
+	DeletedWithArticle bool `gorm:"not null"`
 }
 
 // Validate validates fields of comment model
@@ -28,12 +32,23 @@ func (c Comment) Validate() error {
 	)
 }
 
+// IsHidden returns whether the comment is hidden by moderation
+func (c *Comment) IsHidden() bool {
+	return c.HiddenAt != nil
+}
+
 // ProtoComment generates proto comment model from article
 func (c *Comment) ProtoComment() *pb.Comment {
-	return &pb.Comment{
+	pc := pb.Comment{
 		Id:        fmt.Sprintf("%d", c.ID),
 		Body:      c.Body,
 		CreatedAt: c.CreatedAt.Format(ISO8601),
 		UpdatedAt: c.UpdatedAt.Format(ISO8601),
 	}
+
+	if c.DeletedAt != nil {
+		pc.DeletedAt = c.DeletedAt.Format(ISO8601)
+	}
+
+	return &pc
 }
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// types of domain event
+const (
+	EventArticleCreated     = "article.created"
+	EventArticleUpdated     = "article.updated"
+	EventArticleDeleted     = "article.deleted"
+	EventArticleRestored    = "article.restored"
+	EventArticleFavorited   = "article.favorited"
+	EventArticleUnfavorited = "article.unfavorited"
+	EventCommentCreated     = "comment.created"
+	EventCommentDeleted     = "comment.deleted"
+	EventCommentRestored    = "comment.restored"
+	EventUserFollowed       = "user.followed"
+	EventUserUnfollowed     = "user.unfollowed"
+	EventUserDeleted        = "user.deleted"
+)
+
+// types of aggregate which events belong to
+const (
+	AggregateArticle = "article"
+	AggregateUser    = "user"
+)
+
+// OutboxEvent model is a domain event written in the same transaction
+// as the change, waiting to be dispatched. Events which failed to be
+// dispatched are retried at NextAttemptAt.
+//
+// Pending events are looked up by aggregate to dispatch them in order, on
+// idx_outbox_events_aggregate. Secondary indexes end with the primary key,
+// so the index is on (aggregate_type, aggregate_id, dispatched_at, id).
+type OutboxEvent struct {
+	ID            uint       `gorm:"primary_key"`
+	AggregateType string     `gorm:"type:varchar(16);not null;index:idx_outbox_events_aggregate"`
+	AggregateID   uint       `gorm:"not null;index:idx_outbox_events_aggregate"`
+	Type          string     `gorm:"not null"`
+	Payload       string     `gorm:"type:text"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	DispatchedAt  *time.Time `gorm:"index:idx_outbox_events_dispatched_at,idx_outbox_events_aggregate"`
+	Attempts      int        `gorm:"not null;default:0"`
+	NextAttemptAt *time.Time
+}
+
+func newOutboxEvent(aggregateType string, aggregateID uint, typ string, payload interface{}) *OutboxEvent {
+	// payloads are maps of plain values which always encode
+	b, _ := json.Marshal(payload)
+	return &OutboxEvent{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Type:          typ,
+		Payload:       string(b),
+	}
+}
+
+// NewArticleEvent returns an event of the article
+func NewArticleEvent(typ string, a *Article) *OutboxEvent {
+	return newOutboxEvent(AggregateArticle, a.ID, typ, map[string]interface{}{
+		"articleId": a.ID,
+		"authorId":  a.UserID,
+		"title":     a.Title,
+		"status":    a.Status,
+	})
+}
+
+// NewFavoriteEvent returns an event that the user favorited or unfavorited the article
+func NewFavoriteEvent(typ string, a *Article, u *User) *OutboxEvent {
+	return newOutboxEvent(AggregateArticle, a.ID, typ, map[string]interface{}{
+		"articleId": a.ID,
+		"userId":    u.ID,
+	})
+}
+
+// NewCommentEvent returns an event of the comment, which belongs to its article
+func NewCommentEvent(typ string, c *Comment) *OutboxEvent {
+	return newOutboxEvent(AggregateArticle, c.ArticleID, typ, map[string]interface{}{
+		"articleId": c.ArticleID,
+		"commentId": c.ID,
+		"authorId":  c.UserID,
+	})
+}
+
+// NewFollowEvent returns an event that user a followed or unfollowed user b
+func NewFollowEvent(typ string, a *User, b *User) *OutboxEvent {
+	return newOutboxEvent(AggregateUser, a.ID, typ, map[string]interface{}{
+		"userId":      a.ID,
+		"followingId": b.ID,
+	})
+}
+
+// NewUserEvent returns an event of the user
+func NewUserEvent(typ string, u *User) *OutboxEvent {
+	return newOutboxEvent(AggregateUser, u.ID, typ, map[string]interface{}{
+		"userId": u.ID,
+	})
+}
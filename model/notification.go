@@ -0,0 +1,80 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+)
+
+// types of notification
+const (
+	NotificationFollow   = "follow"
+	NotificationComment  = "comment"
+	NotificationFavorite = "favorite"
+)
+
+// Notification model tells a user what other users did to them
+type Notification struct {
+	gorm.Model
+	UserID    uint   `gorm:"not null;index"`
+	ActorID   uint   `gorm:"not null"`
+	Actor     User   `gorm:"foreignkey:ActorID"`
+	Type      string `gorm:"not null"`
+	ArticleID *uint
+	CommentID *uint
+	ReadAt    *time.Time
+}
+
+// NewFollowNotification returns a notification that actor followed the user
+func NewFollowNotification(actor, user *User) *Notification {
+	return &Notification{
+		UserID:  user.ID,
+		ActorID: actor.ID,
+		Type:    NotificationFollow,
+	}
+}
+
+// NewCommentNotification returns a notification to the author that actor commented on the article
+func NewCommentNotification(actor *User, a *Article, c *Comment) *Notification {
+	return &Notification{
+		UserID:    a.UserID,
+		ActorID:   actor.ID,
+		Type:      NotificationComment,
+		ArticleID: &a.ID,
+		CommentID: &c.ID,
+	}
+}
+
+// NewFavoriteNotification returns a notification to the author that actor favorited the article
+func NewFavoriteNotification(actor *User, a *Article) *Notification {
+	return &Notification{
+		UserID:    a.UserID,
+		ActorID:   actor.ID,
+		Type:      NotificationFavorite,
+		ArticleID: &a.ID,
+	}
+}
+
+// IsRead returns whether the notification has been read
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// ProtoNotification generates proto notification model from notification
+func (n *Notification) ProtoNotification() *pb.Notification {
+	pn := pb.Notification{
+		Id:        fmt.Sprintf("%d", n.ID),
+		Type:      n.Type,
+		Read:      n.IsRead(),
+		CreatedAt: n.CreatedAt.Format(ISO8601),
+	}
+	if n.ArticleID != nil {
+		pn.Slug = fmt.Sprintf("%d", *n.ArticleID)
+	}
+	if n.CommentID != nil {
+		pn.CommentId = fmt.Sprintf("%d", *n.CommentID)
+	}
+	return &pn
+}
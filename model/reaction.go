@@ -0,0 +1,53 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// targets of reaction
+const (
+	ReactionTargetArticle = "article"
+	ReactionTargetComment = "comment"
+)
+
+// DefaultReactionKinds is the reactions available unless configured
+var DefaultReactionKinds = []string{"like", "insightful", "funny", "celebrate", "confused"}
+
+// maxReactionKindLength is the maximum length of a reaction kind
+const maxReactionKindLength = 32
+
+// Reaction model is a reaction of a user to an article or a comment.
+// A user can give one reaction of each kind to the same target.
+type Reaction struct {
+	ID         uint   `gorm:"primary_key"`
+	TargetType string `gorm:"type:varchar(16);not null;unique_index:idx_reactions_target_user_kind"`
+	TargetID   uint   `gorm:"not null;unique_index:idx_reactions_target_user_kind"`
+	UserID     uint   `gorm:"not null;unique_index:idx_reactions_target_user_kind"`
+	Kind       string `gorm:"type:varchar(32);not null;unique_index:idx_reactions_target_user_kind"`
+	CreatedAt  time.Time
+}
+
+// ParseReactionKinds parses comma separated reaction kinds.
+// It returns DefaultReactionKinds if no kinds are given.
+func ParseReactionKinds(s string) ([]string, error) {
+	seen := map[string]bool{}
+	kinds := []string{}
+	for _, k := range strings.Split(s, ",") {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k == "" || seen[k] {
+			continue
+		}
+		if len(k) > maxReactionKindLength {
+			return nil, fmt.Errorf("reaction kind %q is longer than %d characters", k, maxReactionKindLength)
+		}
+		seen[k] = true
+		kinds = append(kinds, k)
+	}
+
+	if len(kinds) == 0 {
+		return DefaultReactionKinds, nil
+	}
+	return kinds, nil
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/jinzhu/gorm"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+)
+
+// types of reported contents
+const (
+	ReportTargetArticle = "article"
+	ReportTargetComment = "comment"
+)
+
+// statuses of report
+const (
+	ReportStatusOpen     = "open"
+	ReportStatusResolved = "resolved"
+)
+
+// actions staff take to resolve reports
+const (
+	ModerationDismiss = "dismiss"
+	ModerationHide    = "hide"
+	ModerationSuspend = "suspend"
+)
+
+// maxReportReasonLength is the maximum length of report reason
+const maxReportReasonLength = 255
+
+// Report model is a flag of abusive content raised by a user
+type Report struct {
+	gorm.Model
+	TargetType string `gorm:"type:varchar(16);not null;index:idx_reports_target"`
+	TargetID   uint   `gorm:"not null;index:idx_reports_target"`
+	ReporterID uint   `gorm:"not null"` // zero for contents held by the content filter
+	Reporter   User   `gorm:"foreignkey:ReporterID"`
+	Reason     string `gorm:"type:varchar(255);not null"`
+	Status     string `gorm:"type:varchar(16);not null;index"`
+	Resolution string `gorm:"type:varchar(16);not null"`
+	ResolverID *uint
+	ResolvedAt *time.Time
+}
+
+// NewHeldReport returns a report of the content held by the content filter.
+// The reason is truncated to fit in the column, as filter reasons can quote
+// whole words or hostnames of the content.
+func NewHeldReport(targetType string, targetID uint, filter, reason string) *Report {
+	reason = fmt.Sprintf("held by %s filter: %s", filter, reason)
+	if rs := []rune(reason); len(rs) > maxReportReasonLength {
+		reason = string(rs[:maxReportReasonLength-1]) + "…"
+	}
+
+	return &Report{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     reason,
+	}
+}
+
+// Validate validates fields of report model
+func (r Report) Validate() error {
+	return validation.Errors{
+		"target_type": validation.Validate(r.TargetType,
+			validation.Required,
+			validation.In(ReportTargetArticle, ReportTargetComment),
+		),
+		"target_id": validation.Validate(r.TargetID,
+			validation.Required,
+		),
+		"reason": validation.Validate(r.Reason,
+			validation.Required,
+			validation.RuneLength(0, maxReportReasonLength),
+		),
+	}.Filter()
+}
+
+// IsModerationAction returns whether staff can resolve reports with the action
+func IsModerationAction(action string) bool {
+	switch action {
+	case ModerationDismiss, ModerationHide, ModerationSuspend:
+		return true
+	}
+	return false
+}
+
+// ProtoReport generates proto report model from report
+func (r *Report) ProtoReport() *pb.Report {
+	pr := pb.Report{
+		Id:         fmt.Sprintf("%d", r.ID),
+		TargetType: r.TargetType,
+		TargetId:   fmt.Sprintf("%d", r.TargetID),
+		Reason:     r.Reason,
+		CreatedAt:  r.CreatedAt.Format(ISO8601),
+	}
+
+	if r.ReporterID != 0 {
+		pr.Reporter = r.Reporter.ProtoProfile(false)
+	}
+
+	return &pr
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+)
+
+// ArticleRevision model is a snapshot of article contents
+type ArticleRevision struct {
+	gorm.Model
+	ArticleID   uint   `gorm:"not null;index"`
+	UserID      uint   `gorm:"not null"`
+	Author      User   `gorm:"foreignkey:UserID"`
+	Title       string `gorm:"not null"`
+	Description string `gorm:"not null"`
+	Body        string `gorm:"not null"`
+}
+
+// NewArticleRevision returns a revision holding current contents of the article
+func NewArticleRevision(a *Article) *ArticleRevision {
+	return &ArticleRevision{
+		ArticleID:   a.ID,
+		UserID:      a.UserID,
+		Title:       a.Title,
+		Description: a.Description,
+		Body:        a.Body,
+	}
+}
+
+// SameContent returns whether the revision has the same contents as the article
+func (r *ArticleRevision) SameContent(a *Article) bool {
+	return r.Title == a.Title && r.Description == a.Description && r.Body == a.Body
+}
+
+// Text returns the revision contents as a single document
+func (r *ArticleRevision) Text() string {
+	return contentText(r.Title, r.Description, r.Body)
+}
+
+// ProtoArticleRevision generates proto article revision model from revision
+func (r *ArticleRevision) ProtoArticleRevision() *pb.ArticleRevision {
+	return &pb.ArticleRevision{
+		Id:          fmt.Sprintf("%d", r.ID),
+		Title:       r.Title,
+		Description: r.Description,
+		Body:        r.Body,
+		CreatedAt:   r.CreatedAt.Format(ISO8601),
+	}
+}
+
+func contentText(title, description, body string) string {
+	return fmt.Sprintf("%s\n\n%s\n\n%s\n", title, description, body)
+}
@@ -2,7 +2,9 @@ package model
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
@@ -14,12 +16,21 @@ import (
 // User is user model
 type User struct {
 	gorm.Model
-	Username         string    `gorm:"unique_index;not null"`
-	Email            string    `gorm:"unique_index;not null"`
-	Password         string    `gorm:"not null"`
-	Bio              string    `gorm:"not null"`
-	Image            string    `gorm:"not null"`
+	Username         string `gorm:"unique_index;not null"`
+	Email            string `gorm:"unique_index;not null"`
+	Password         string `gorm:"not null"`
+	Bio              string `gorm:"not null"`
+	Image            string `gorm:"not null"`
+	Admin            bool   `gorm:"not null;default:false"`
+	FollowersCount   int32  `gorm:"not null;default:0"`
+	FollowingCount   int32  `gorm:"not null;default:0"`
+	ArticlesCount    int32  `gorm:"not null;default:0"`
+	FavoritesCount   int32  `gorm:"not null;default:0"`
+	SuspendedAt      *time.Time
+	AnonymizedAt     *time.Time
 	Follows          []User    `gorm:"many2many:follows;jointable_foreignkey:from_user_id;association_jointable_foreignkey:to_user_id"`
+	Blocks           []User    `gorm:"many2many:blocks;jointable_foreignkey:from_user_id;association_jointable_foreignkey:to_user_id"`
+	Mutes            []User    `gorm:"many2many:mutes;jointable_foreignkey:from_user_id;association_jointable_foreignkey:to_user_id"`
 	FavoriteArticles []Article `gorm:"many2many:favorite_articles;"`
 }
 
@@ -43,6 +54,32 @@ func (u User) Validate() error {
 	)
 }
 
+// DeletedUsername is the name shown for contents of users who deleted their accounts
+const DeletedUsername = "deleted user"
+
+// IsSuspended returns whether the user is suspended by moderation
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// IsAnonymized returns whether the user deleted the account
+func (u *User) IsAnonymized() bool {
+	return u.AnonymizedAt != nil
+}
+
+// Anonymize erases personal data of the user who deleted the account.
+// Username and email are replaced with unique placeholders so that they can be
+// registered again, and the empty password never matches.
+func (u *User) Anonymize(t time.Time) {
+	u.Username = fmt.Sprintf("deleted-user-%d", u.ID)
+	u.Email = fmt.Sprintf("deleted-user-%d@deleted.invalid", u.ID)
+	u.Password = ""
+	u.Bio = ""
+	u.Image = ""
+	u.Admin = false
+	u.AnonymizedAt = &t
+}
+
 // HashPassword makes password field crypted
 func (u *User) HashPassword() error {
 	if len(u.Password) == 0 {
@@ -77,10 +114,19 @@ func (u *User) ProtoUser(token string) *pb.User {
 
 // ProtoProfile generates proto profile model from user
 func (u *User) ProtoProfile(following bool) *pb.Profile {
+	username := u.Username
+	if u.IsAnonymized() {
+		username = DeletedUsername
+	}
+
 	return &pb.Profile{
-		Username:  u.Username,
-		Bio:       u.Bio,
-		Image:     u.Image,
-		Following: following,
+		Username:       username,
+		Bio:            u.Bio,
+		Image:          u.Image,
+		Following:      following,
+		FollowersCount: u.FollowersCount,
+		FollowingCount: u.FollowingCount,
+		ArticlesCount:  u.ArticlesCount,
+		FavoritesCount: u.FavoritesCount,
 	}
 }
@@ -0,0 +1,142 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+	"github.com/jinzhu/gorm"
+	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+)
+
+// WebhookEvents is the list of events webhooks can subscribe
+var WebhookEvents = []interface{}{
+	EventArticleCreated,
+	EventArticleUpdated,
+	EventArticleDeleted,
+	EventArticleRestored,
+	EventArticleFavorited,
+	EventCommentCreated,
+}
+
+// statuses of webhook delivery
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// Webhook model is a subscription of events by an external service
+type Webhook struct {
+	gorm.Model
+	URL    string `gorm:"not null"`
+	Secret string `gorm:"not null"`
+	Events string `gorm:"not null"` // comma separated
+	Active bool   `gorm:"not null"`
+}
+
+// Validate validates fields of webhook model
+func (w Webhook) Validate() error {
+	return validation.Errors{
+		"url": validation.Validate(w.URL,
+			validation.Required,
+			is.URL,
+		),
+		"secret": validation.Validate(w.Secret,
+			validation.Required,
+			validation.Length(16, 0),
+		),
+		"events": validation.Validate(w.EventList(),
+			validation.Required,
+			validation.Each(validation.In(WebhookEvents...)),
+		),
+	}.Filter()
+}
+
+// EventList returns events the webhook subscribes
+func (w *Webhook) EventList() []string {
+	if w.Events == "" {
+		return []string{}
+	}
+	return strings.Split(w.Events, ",")
+}
+
+// SetEvents sets events the webhook subscribes
+func (w *Webhook) SetEvents(events []string) {
+	w.Events = strings.Join(events, ",")
+}
+
+// Subscribes returns whether the webhook subscribes the event
+func (w *Webhook) Subscribes(event string) bool {
+	for _, e := range w.EventList() {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// ProtoWebhook generates proto webhook model from webhook
+func (w *Webhook) ProtoWebhook() *pb.Webhook {
+	return &pb.Webhook{
+		Id:        fmt.Sprintf("%d", w.ID),
+		Url:       w.URL,
+		Events:    w.EventList(),
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt.Format(ISO8601),
+		UpdatedAt: w.UpdatedAt.Format(ISO8601),
+	}
+}
+
+// WebhookDelivery model is a log of delivering an event to a webhook
+type WebhookDelivery struct {
+	gorm.Model
+	WebhookID      uint   `gorm:"not null;index"`
+	Event          string `gorm:"not null"`
+	Payload        string `gorm:"type:text"`
+	Status         string `gorm:"not null;index"`
+	Attempts       int    `gorm:"not null"`
+	NextAttemptAt  *time.Time
+	ResponseStatus int
+	ResponseBody   string `gorm:"type:text"`
+	Error          string `gorm:"type:text"`
+	DeliveredAt    *time.Time
+}
+
+// NewWebhookDelivery returns a pending delivery of the event
+func NewWebhookDelivery(w *Webhook, event, payload string, now time.Time) *WebhookDelivery {
+	// DATETIME columns round fractional seconds, which could postpone the first attempt
+	now = now.Truncate(time.Second)
+	return &WebhookDelivery{
+		WebhookID:     w.ID,
+		Event:         event,
+		Payload:       payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: &now,
+	}
+}
+
+// ProtoWebhookDelivery generates proto webhook delivery model from delivery
+func (d *WebhookDelivery) ProtoWebhookDelivery() *pb.WebhookDelivery {
+	pd := pb.WebhookDelivery{
+		Id:             fmt.Sprintf("%d", d.ID),
+		WebhookId:      fmt.Sprintf("%d", d.WebhookID),
+		Event:          d.Event,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		ResponseStatus: int32(d.ResponseStatus),
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt.Format(ISO8601),
+	}
+	if d.NextAttemptAt != nil {
+		pd.NextAttemptAt = d.NextAttemptAt.Format(ISO8601)
+	}
+	if d.DeliveredAt != nil {
+		pd.DeliveredAt = d.DeliveredAt.Format(ISO8601)
+	}
+	return &pd
+}
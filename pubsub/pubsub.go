@@ -0,0 +1,148 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrSlowSubscriber is returned by Subscription.Err when the subscription
+// was dropped because it could not keep up with published messages
+var ErrSlowSubscriber = errors.New("subscriber is too slow")
+
+// PubSub delivers messages published on a topic to its subscribers.
+// Messages are opaque bytes so that implementations can pass them across processes.
+type PubSub interface {
+	Publish(ctx context.Context, topic string, data []byte) error
+	Subscribe(ctx context.Context, topic string) (Subscription, error)
+}
+
+// Subscription receives messages of a topic
+type Subscription interface {
+	// Messages returns a channel of messages, closed when the subscription ends
+	Messages() <-chan []byte
+	// Err returns why the subscription ended, nil when it was closed by the subscriber
+	Err() error
+	// Close ends the subscription
+	Close() error
+}
+
+// DefaultBufferSize is the number of messages buffered per subscription
+const DefaultBufferSize = 64
+
+// Memory is an in-process PubSub. Publish never blocks; subscribers whose
+// buffer is full are dropped with ErrSlowSubscriber.
+type Memory struct {
+	mu         sync.RWMutex
+	subs       map[string]map[*memorySubscription]struct{}
+	bufferSize int
+}
+
+// NewMemory returns a new Memory which buffers bufferSize messages per subscription
+func NewMemory(bufferSize int) *Memory {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &Memory{
+		subs:       map[string]map[*memorySubscription]struct{}{},
+		bufferSize: bufferSize,
+	}
+}
+
+// Publish sends data to current subscribers of the topic
+func (m *Memory) Publish(ctx context.Context, topic string, data []byte) error {
+	var slow []*memorySubscription
+
+	m.mu.RLock()
+	for s := range m.subs[topic] {
+		select {
+		case s.ch <- data:
+		default:
+			slow = append(slow, s)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, s := range slow {
+		s.close(ErrSlowSubscriber)
+	}
+
+	return nil
+}
+
+// Subscribe starts a subscription of the topic, which is closed when ctx is done
+func (m *Memory) Subscribe(ctx context.Context, topic string) (Subscription, error) {
+	s := &memorySubscription{
+		m:     m,
+		topic: topic,
+		ch:    make(chan []byte, m.bufferSize),
+		done:  make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	if m.subs[topic] == nil {
+		m.subs[topic] = map[*memorySubscription]struct{}{}
+	}
+	m.subs[topic][s] = struct{}{}
+	m.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.close(nil)
+		case <-s.done:
+		}
+	}()
+
+	return s, nil
+}
+
+// Subscribers returns the number of subscriptions of the topic
+func (m *Memory) Subscribers(topic string) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.subs[topic])
+}
+
+type memorySubscription struct {
+	m     *Memory
+	topic string
+	ch    chan []byte
+	done  chan struct{}
+	once  sync.Once
+	err   error
+}
+
+func (s *memorySubscription) Messages() <-chan []byte {
+	return s.ch
+}
+
+func (s *memorySubscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+func (s *memorySubscription) Close() error {
+	s.close(nil)
+	return nil
+}
+
+func (s *memorySubscription) close(err error) {
+	s.once.Do(func() {
+		s.m.mu.Lock()
+		delete(s.m.subs[s.topic], s)
+		if len(s.m.subs[s.topic]) == 0 {
+			delete(s.m.subs, s.topic)
+		}
+		s.m.mu.Unlock()
+
+		// publishers send under the read lock, so no send happens after this
+		s.err = err
+		close(s.done)
+		close(s.ch)
+	})
+}
@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Job is a task which runs periodically
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(now time.Time) error
+}
+
+// Scheduler runs registered jobs in background
+type Scheduler struct {
+	logger *zerolog.Logger
+	jobs   []Job
+}
+
+// New returns a new scheduler
+func New(l *zerolog.Logger) *Scheduler {
+	return &Scheduler{logger: l}
+}
+
+// Add registers a job
+func (s *Scheduler) Add(j Job) {
+	s.jobs = append(s.jobs, j)
+}
+
+// Start runs every job on its own ticker until ctx is canceled
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		go s.loop(ctx, j)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, j Job) {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := j.Run(now); err != nil {
+				s.logger.Error().Err(err).Str("job", j.Name).Msg("failed to run scheduled job")
+			}
+		}
+	}
+}
@@ -1,29 +1,82 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/raahii/golang-grpc-realworld-example/blob"
+	"github.com/raahii/golang-grpc-realworld-example/cache"
 	"github.com/raahii/golang-grpc-realworld-example/db"
+	"github.com/raahii/golang-grpc-realworld-example/events"
+	"github.com/raahii/golang-grpc-realworld-example/filter"
 	"github.com/raahii/golang-grpc-realworld-example/handler"
+	"github.com/raahii/golang-grpc-realworld-example/health"
+	"github.com/raahii/golang-grpc-realworld-example/logging"
+	"github.com/raahii/golang-grpc-realworld-example/metrics"
+	"github.com/raahii/golang-grpc-realworld-example/model"
 	pb "github.com/raahii/golang-grpc-realworld-example/proto"
+	"github.com/raahii/golang-grpc-realworld-example/pubsub"
+	"github.com/raahii/golang-grpc-realworld-example/scheduler"
 	"github.com/raahii/golang-grpc-realworld-example/store"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
 const (
 	port = ":50051"
+
+	publishInterval = 30 * time.Second
+
+	webhookInterval = 5 * time.Second
+
+	dispatchInterval = 1 * time.Second
+
+	pruneEventsInterval = 1 * time.Hour
+
+	reconcileInterval = 1 * time.Hour
+
+	purgeInterval = 1 * time.Hour
+
+	defaultImageDir = "data/images"
+
+	defaultEventLog = "data/events.ndjson"
+
+	defaultMaxLinks = 5
+
+	defaultDuplicateWindow = 10 * time.Minute
+
+	defaultCacheTTL = 1 * time.Minute
+
+	defaultAdminAddr = ":9090"
+
+	cacheStatsInterval = 5 * time.Minute
+
+	healthCheckInterval = 10 * time.Second
 )
 
 func main() {
 	w := zerolog.ConsoleWriter{Out: os.Stderr}
 	l := zerolog.New(w).With().Timestamp().Caller().Logger()
 
+	shutdown, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv("realworld-server"))
+	if err != nil {
+		l.Fatal().Err(err).Msg("failed to set up tracing")
+	}
+	defer shutdown(context.Background())
+
 	d, err := db.New()
 	if err != nil {
 		err = fmt.Errorf("failed to connect database: %w", err)
@@ -33,15 +86,138 @@ func main() {
 		Str("database", d.Dialect().CurrentDatabase()).
 		Msg("succeeded to connect to the database")
 
+	// the server is not ready until the database is migrated
+	hc := health.NewChecker(d.DB())
+
 	err = db.AutoMigrate(d)
 	if err != nil {
 		l.Fatal().Err(err).Msg("failed to migrate database")
 	}
+	hc.SetMigrated()
+
+	c, err := newCache()
+	if err != nil {
+		l.Fatal().Err(err).Msg("failed to configure cache")
+	}
+
+	if err := metrics.RegisterDB(d.DB(), d.Dialect().CurrentDatabase()); err != nil {
+		l.Fatal().Err(err).Msg("failed to register database metrics")
+	}
+	if err := metrics.RegisterCache(c); err != nil {
+		l.Fatal().Err(err).Msg("failed to register cache metrics")
+	}
+
+	us := store.NewCachedUserStore(store.NewUserStore(d), c)
+	as := store.NewCachedArticleStore(store.NewArticleStore(d), c)
+	ns := store.NewNotificationStore(d)
+	ws := store.NewWebhookStore(d)
+	rs := store.NewCachedReportStore(store.NewReportStore(d), c)
+
+	imageDir := os.Getenv("IMAGE_DIR")
+	if imageDir == "" {
+		imageDir = defaultImageDir
+	}
+	bs, err := blob.NewLocalStorage(imageDir)
+	if err != nil {
+		l.Fatal().Err(err).Msg("failed to initialize image storage")
+	}
+
+	eventLog := os.Getenv("EVENT_LOG")
+	if eventLog == "" {
+		eventLog = defaultEventLog
+	}
+	if err := os.MkdirAll(filepath.Dir(eventLog), 0755); err != nil {
+		l.Fatal().Err(err).Msg("failed to create event log directory")
+	}
+	fs, err := events.NewFileSink(eventLog)
+	if err != nil {
+		l.Fatal().Err(err).Msg("failed to open event log")
+	}
+	defer fs.Close()
+
+	ev := events.NewDispatcher(&l, store.NewOutboxStore(d))
+	ev.AddSink(fs)
+
+	cf, err := newContentFilter()
+	if err != nil {
+		l.Fatal().Err(err).Msg("failed to configure content filter")
+	}
+
+	hcfg, err := newHandlerConfig()
+	if err != nil {
+		l.Fatal().Err(err).Msg("failed to configure handler")
+	}
+
+	h := handler.New(&l, us, as, ns, ws, rs, bs, pubsub.NewMemory(pubsub.DefaultBufferSize), ev, cf, hcfg)
+
+	// articles written before bodies were rendered on write
+	if err := h.RenderArticleBodies(); err != nil {
+		l.Fatal().Err(err).Msg("failed to backfill article bodies")
+	}
 
-	us := store.NewUserStore(d)
-	as := store.NewArticleStore(d)
+	sc := scheduler.New(&l)
+	sc.Add(scheduler.Job{
+		Name:     "publish scheduled articles",
+		Interval: publishInterval,
+		Run:      h.PublishScheduledArticles,
+	})
+	sc.Add(scheduler.Job{
+		Name:     "deliver webhooks",
+		Interval: webhookInterval,
+		Run:      h.DeliverWebhooks,
+	})
+	sc.Add(scheduler.Job{
+		Name:     "dispatch events",
+		Interval: dispatchInterval,
+		Run:      h.DispatchEvents,
+	})
+	sc.Add(scheduler.Job{
+		Name:     "prune events",
+		Interval: pruneEventsInterval,
+		Run:      h.PruneEvents,
+	})
+	sc.Add(scheduler.Job{
+		Name:     "reconcile profile counters",
+		Interval: reconcileInterval,
+		Run:      h.ReconcileProfileCounters,
+	})
+	sc.Add(scheduler.Job{
+		Name:     "purge trash",
+		Interval: purgeInterval,
+		Run:      h.PurgeTrash,
+	})
+	sc.Add(scheduler.Job{
+		Name:     "check health",
+		Interval: healthCheckInterval,
+		Run: func(time.Time) error {
+			return hc.Check()
+		},
+	})
+	sc.Add(scheduler.Job{
+		Name:     "report cache stats",
+		Interval: cacheStatsInterval,
+		Run: func(now time.Time) error {
+			st := c.Stats()
+			l.Info().Uint64("hits", st.Hits).Uint64("misses", st.Misses).
+				Uint64("errors", st.Errors).Msg("cache stats")
+			return nil
+		},
+	})
+	sc.Start(context.Background())
 
-	h := handler.New(&l, us, as)
+	// metrics are served apart from the public API
+	adminAddr := os.Getenv("ADMIN_ADDR")
+	if adminAddr == "" {
+		adminAddr = defaultAdminAddr
+	}
+	admin := http.NewServeMux()
+	admin.Handle("/metrics", metrics.Handler())
+	go func() {
+		l.Info().Str("addr", adminAddr).Msg("starting admin server")
+		if err := http.ListenAndServe(adminAddr, admin); err != nil {
+			l.Error().Err(err).Msg("admin server stopped")
+		}
+	}()
 
 	lis, err := net.Listen("tcp", port)
 	if err != nil {
@@ -49,14 +225,160 @@ func main() {
 	}
 
 	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc_middleware.WithUnaryServerChain(
+			metrics.UnaryServerInterceptor,
+			logging.UnaryServerInterceptor(&l),
 			grpc_recovery.UnaryServerInterceptor(),
+			grpc_auth.UnaryServerInterceptor(h.AuthFunc),
+			handler.UnaryETagInterceptor,
+		),
+		grpc_middleware.WithStreamServerChain(
+			metrics.StreamServerInterceptor,
+			logging.StreamServerInterceptor(&l),
+			grpc_recovery.StreamServerInterceptor(),
+			grpc_auth.StreamServerInterceptor(h.AuthFunc),
 		),
 	)
 	pb.RegisterUsersServer(s, h)
 	pb.RegisterArticlesServer(s, h)
+	pb.RegisterImagesServer(s, h)
+	pb.RegisterNotificationsServer(s, h)
+	pb.RegisterWebhooksServer(s, h)
+	pb.RegisterModerationServer(s, h)
+	hc.Register(s)
+	if err := hc.Check(); err != nil {
+		l.Error().Err(err).Msg("server is not ready")
+	}
 	l.Info().Str("port", port).Msg("starting server")
 	if err := s.Serve(lis); err != nil {
 		l.Panic().Err(fmt.Errorf("failed to serve: %w", err))
 	}
 }
+
+// newCache builds the cache of hot reads from environment variables
+func newCache() (*cache.Cache, error) {
+	ttl := defaultCacheTTL
+	if s := os.Getenv("CACHE_TTL"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid $CACHE_TTL: %w", err)
+		}
+		ttl = d
+	}
+
+	switch b := os.Getenv("CACHE_BACKEND"); b {
+	case "", "memory":
+		size := cache.DefaultMemorySize
+		if s := os.Getenv("CACHE_SIZE"); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid $CACHE_SIZE: %w", err)
+			}
+			size = n
+		}
+		return cache.New(cache.NewMemory(size), ttl), nil
+	case "redis":
+		addr := os.Getenv("REDIS_ADDR")
+		if addr == "" {
+			return nil, fmt.Errorf("$REDIS_ADDR is required for the redis cache")
+		}
+		return cache.New(cache.NewRedis(addr), ttl), nil
+	default:
+		return nil, fmt.Errorf("unknown $CACHE_BACKEND: %s", b)
+	}
+}
+
+// newHandlerConfig builds the configuration of the handler from environment variables
+func newHandlerConfig() (handler.Config, error) {
+	c := handler.DefaultConfig()
+
+	if s := os.Getenv("IMAGE_BASE_URL"); s != "" {
+		u, err := url.Parse(s)
+		if err != nil {
+			return c, fmt.Errorf("invalid $IMAGE_BASE_URL: %w", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return c, fmt.Errorf("invalid $IMAGE_BASE_URL: %s is not an absolute http(s) URL", s)
+		}
+		c.ImageBaseURL = s
+	}
+
+	if s := os.Getenv("EVENT_RETENTION"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return c, fmt.Errorf("invalid $EVENT_RETENTION: %w", err)
+		}
+		if d <= 0 {
+			return c, fmt.Errorf("invalid $EVENT_RETENTION: %s is not positive", s)
+		}
+		c.EventRetention = d
+	}
+
+	kinds, err := model.ParseReactionKinds(os.Getenv("REACTION_KINDS"))
+	if err != nil {
+		return c, fmt.Errorf("invalid $REACTION_KINDS: %w", err)
+	}
+	c.ReactionKinds = kinds
+
+	if s := os.Getenv("MODERATION_AUTO_HIDE_REPORTS"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return c, fmt.Errorf("invalid $MODERATION_AUTO_HIDE_REPORTS: %w", err)
+		}
+		if n < 0 {
+			return c, fmt.Errorf("invalid $MODERATION_AUTO_HIDE_REPORTS: %s is negative", s)
+		}
+		c.AutoHideReports = n
+	}
+
+	if s := os.Getenv("TRASH_RETENTION"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return c, fmt.Errorf("invalid $TRASH_RETENTION: %w", err)
+		}
+		if d <= 0 {
+			return c, fmt.Errorf("invalid $TRASH_RETENTION: %s is not positive", s)
+		}
+		c.TrashRetention = d
+	}
+
+	switch s := os.Getenv("ACCOUNT_DELETION_MODE"); s {
+	case "":
+	case handler.AccountDeletionAnonymize, handler.AccountDeletionRemove:
+		c.AccountDeletionMode = s
+	default:
+		return c, fmt.Errorf("invalid $ACCOUNT_DELETION_MODE: %s is neither %s nor %s",
+			s, handler.AccountDeletionAnonymize, handler.AccountDeletionRemove)
+	}
+
+	return c, nil
+}
+
+// newContentFilter builds the content filter from environment variables
+func newContentFilter() (filter.Chain, error) {
+	maxLinks := defaultMaxLinks
+	if s := os.Getenv("CONTENT_FILTER_MAX_LINKS"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid $CONTENT_FILTER_MAX_LINKS: %w", err)
+		}
+		maxLinks = n
+	}
+
+	window := defaultDuplicateWindow
+	if s := os.Getenv("CONTENT_FILTER_DUPLICATE_WINDOW"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid $CONTENT_FILTER_DUPLICATE_WINDOW: %w", err)
+		}
+		window = d
+	}
+
+	return filter.Chain{
+		filter.NewWordList(filter.ParseList(os.Getenv("CONTENT_FILTER_BLOCKED_WORDS")), filter.Reject),
+		filter.NewWordList(filter.ParseList(os.Getenv("CONTENT_FILTER_HELD_WORDS")), filter.Hold),
+		filter.NewLinks(maxLinks, filter.ParseList(os.Getenv("CONTENT_FILTER_BLOCKED_HOSTS"))),
+		filter.NewDuplicates(window),
+	}, nil
+}
@@ -1,8 +1,12 @@
 package store
 
 import (
+	"context"
+	"time"
+
 	"github.com/jinzhu/gorm"
 	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
 )
 
 // ArticleStore is data access struct for user
@@ -17,6 +21,13 @@ func NewArticleStore(db *gorm.DB) *ArticleStore {
 	}
 }
 
+// WithContext returns the ArticleStore whose queries are traced in ctx
+func (s *ArticleStore) WithContext(ctx context.Context) *ArticleStore {
+	return &ArticleStore{
+		db: tracing.WithContext(s.db, ctx),
+	}
+}
+
 // GetByID finds an article from id
 func (s *ArticleStore) GetByID(id uint) (*model.Article, error) {
 	var m model.Article
@@ -27,19 +38,196 @@ func (s *ArticleStore) GetByID(id uint) (*model.Article, error) {
 	return &m, nil
 }
 
-// Create creates an article
+// Create creates an article with its first revision
 func (s *ArticleStore) Create(m *model.Article) error {
-	return s.db.Create(&m).Error
+	tx := s.db.Begin()
+
+	// not to overwrite author's counters with a stale copy
+	err := tx.Set("gorm:association_autoupdate", false).Create(&m).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if m.IsPublished() {
+		if err := addCount(tx, m.UserID, "articles_count", 1); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Create(model.NewArticleRevision(m)).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewArticleEvent(model.EventArticleCreated, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
 }
 
-// Update updates an article
+// Update updates an article and records a revision if its contents changed
 func (s *ArticleStore) Update(m *model.Article) error {
-	return s.db.Model(&m).Update(&m).Error
+	tx := s.db.Begin()
+
+	var prev model.Article
+	if err := tx.Select("status").First(&prev, m.ID).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// not to overwrite author's counters with a stale copy
+	err := tx.Set("gorm:association_autoupdate", false).Model(&m).Update(&m).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// count the article when it gets published
+	if !prev.IsPublished() && m.IsPublished() {
+		if err := addCount(tx, m.UserID, "articles_count", 1); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := addRevision(tx, m); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewArticleEvent(model.EventArticleUpdated, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// addRevision records current contents of the article unless the latest revision has them
+func addRevision(tx *gorm.DB, m *model.Article) error {
+	var latest model.ArticleRevision
+	err := tx.Where("article_id = ?", m.ID).Order("id desc").First(&latest).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+
+	if err == nil && latest.SameContent(m) {
+		return nil
+	}
+
+	return tx.Create(model.NewArticleRevision(m)).Error
 }
 
-// GetArticles get global articles
-func (s *ArticleStore) GetArticles(tagName, username string, favoritedBy *model.User, limit, offset int64) ([]model.Article, error) {
-	d := s.db.Preload("Author")
+// GetRevisions returns revisions of the article, newest first
+func (s *ArticleStore) GetRevisions(m *model.Article, limit, offset int64) ([]model.ArticleRevision, error) {
+	var rs []model.ArticleRevision
+	err := s.db.Preload("Author").
+		Where("article_id = ?", m.ID).
+		Order("id desc").
+		Offset(offset).Limit(limit).
+		Find(&rs).Error
+	if err != nil {
+		return rs, err
+	}
+	return rs, nil
+}
+
+// GetRevisionByID finds a revision of the article from id
+func (s *ArticleStore) GetRevisionByID(m *model.Article, id uint) (*model.ArticleRevision, error) {
+	var r model.ArticleRevision
+	err := s.db.Preload("Author").
+		Where("article_id = ?", m.ID).
+		First(&r, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RestoreRevision overwrites the article contents with the revision and records it as a new revision
+func (s *ArticleStore) RestoreRevision(m *model.Article, r *model.ArticleRevision) error {
+	m.Title = r.Title
+	m.Description = r.Description
+	m.Body = r.Body
+	if err := m.RenderBody(); err != nil {
+		return err
+	}
+
+	tx := s.db.Begin()
+
+	err := tx.Set("gorm:association_autoupdate", false).Model(m).Updates(map[string]interface{}{
+		"title":        m.Title,
+		"description":  m.Description,
+		"body":         m.Body,
+		"body_html":    m.BodyHTML,
+		"word_count":   m.WordCount,
+		"reading_time": m.ReadingTime,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addRevision(tx, m); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewArticleEvent(model.EventArticleUpdated, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// RenderMissingBodies renders bodies of up to limit articles after the id
+// which were written before bodies were rendered, including deleted ones,
+// and returns them
+func (s *ArticleStore) RenderMissingBodies(after uint, limit int64) ([]model.Article, error) {
+	var as []model.Article
+	err := s.db.Unscoped().
+		Where("id > ? AND body_html IS NULL", after).
+		Order("id asc").
+		Limit(limit).
+		Find(&as).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range as {
+		if err := as[i].RenderBody(); err != nil {
+			return nil, err
+		}
+
+		// not to touch updated_at of the articles
+		err := s.db.Table("articles").Where("id = ?", as[i].ID).UpdateColumns(map[string]interface{}{
+			"body_html":    as[i].BodyHTML,
+			"word_count":   as[i].WordCount,
+			"reading_time": as[i].ReadingTime,
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return as, nil
+}
+
+// GetArticles get global articles except ones written by hidden users
+// and ones hidden by moderation
+func (s *ArticleStore) GetArticles(tagName, username string, favoritedBy *model.User, hiddenUserIDs []uint, limit, offset int64) ([]model.Article, error) {
+	d := s.db.Preload("Author").
+		Where("articles.status = ?", model.ArticleStatusPublished).
+		Where("articles.hidden_at IS NULL")
+
+	if len(hiddenUserIDs) > 0 {
+		d = d.Where("articles.user_id not in (?)", hiddenUserIDs)
+	}
 
 	// author query (has one)
 	if username != "" {
@@ -55,24 +243,10 @@ func (s *ArticleStore) GetArticles(tagName, username string, favoritedBy *model.
 			Where("tags.name = ?", tagName)
 	}
 
-	// favorited query
+	// favorited query, paged together with the other conditions
 	if favoritedBy != nil {
-		rows, err := s.db.Select("article_id").
-			Table("favorite_articles").
-			Where("user_id = ?", favoritedBy.ID).
-			Offset(offset).Limit(limit).Rows()
-		if err != nil {
-			return []model.Article{}, err
-		}
-		defer rows.Close()
-
-		var ids []uint
-		for rows.Next() {
-			var id uint
-			rows.Scan(&id)
-			ids = append(ids, id)
-		}
-		d = d.Where("id in (?)", ids)
+		d = d.Joins("join favorite_articles on articles.id = favorite_articles.article_id").
+			Where("favorite_articles.user_id = ?", favoritedBy.ID)
 	}
 
 	// offset query, limit query
@@ -87,7 +261,9 @@ func (s *ArticleStore) GetArticles(tagName, username string, favoritedBy *model.
 // GetFeedArticles returns following users' articles
 func (s *ArticleStore) GetFeedArticles(userIDs []uint, limit, offset int64) ([]model.Article, error) {
 	d := s.db.Preload("Author").
-		Where("user_id in (?)", userIDs)
+		Where("user_id in (?)", userIDs).
+		Where("status = ?", model.ArticleStatusPublished).
+		Where("hidden_at IS NULL")
 
 	// offset query, limit query
 	d = d.Offset(offset).Limit(limit)
@@ -98,9 +274,238 @@ func (s *ArticleStore) GetFeedArticles(userIDs []uint, limit, offset int64) ([]m
 	return as, err
 }
 
-// Delete deletes an article
+// GetAuthoredArticles returns all articles the author wrote including drafts, oldest first
+func (s *ArticleStore) GetAuthoredArticles(author *model.User, limit, offset int64) ([]model.Article, error) {
+	var as []model.Article
+	err := s.db.Preload("Tags").
+		Where("user_id = ?", author.ID).
+		Order("id").
+		Offset(offset).Limit(limit).
+		Find(&as).Error
+	return as, err
+}
+
+// GetDraftArticles returns the author's articles which are not published yet
+func (s *ArticleStore) GetDraftArticles(author *model.User, limit, offset int64) ([]model.Article, error) {
+	d := s.db.Preload("Author").Preload("Tags").
+		Where("user_id = ?", author.ID).
+		Where("status <> ?", model.ArticleStatusPublished)
+
+	// offset query, limit query
+	d = d.Offset(offset).Limit(limit)
+
+	var as []model.Article
+	err := d.Find(&as).Error
+
+	return as, err
+}
+
+// PublishDueArticles publishes scheduled articles whose publish time is before t
+// and returns the published articles
+func (s *ArticleStore) PublishDueArticles(t time.Time) ([]model.Article, error) {
+	var as []model.Article
+	err := s.db.Preload("Author").Preload("Tags").
+		Where("status = ? AND publish_at <= ?", model.ArticleStatusScheduled, t).
+		Find(&as).Error
+	if err != nil || len(as) == 0 {
+		return nil, err
+	}
+
+	tx := s.db.Begin()
+
+	// each article is published with its own guarded update, since another
+	// instance or PublishArticle may publish it between the query and the update
+	published := make([]model.Article, 0, len(as))
+	for i := range as {
+		res := tx.Model(&model.Article{}).
+			Where("id = ? AND status = ?", as[i].ID, model.ArticleStatusScheduled).
+			Update("status", model.ArticleStatusPublished)
+		if res.Error != nil {
+			tx.Rollback()
+			return nil, res.Error
+		}
+		if res.RowsAffected != 1 {
+			continue
+		}
+
+		as[i].Status = model.ArticleStatusPublished
+		if err := addCount(tx, as[i].UserID, "articles_count", 1); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := addEvents(tx, model.NewArticleEvent(model.EventArticleUpdated, &as[i])); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		published = append(published, as[i])
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return published, nil
+}
+
+// Delete deletes an article with its comments and revisions.
+// Tags, favorites, bookmarks and reactions are kept until the article is purged
+// so that they come back when it is restored.
 func (s *ArticleStore) Delete(m *model.Article) error {
-	return s.db.Delete(m).Error
+	tx := s.db.Begin()
+
+	now := gorm.NowFunc()
+	if err := tx.Model(m).UpdateColumn("deleted_at", now).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// mark the comments so that restoring the article brings back only them,
+	// not the ones deleted before
+	err := tx.Model(&model.Comment{}).
+		Where("article_id = ?", m.ID).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at":           now,
+			"deleted_with_article": true,
+		}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Where("article_id = ?", m.ID).
+		Delete(&model.ArticleRevision{}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// uncount the article and favorites it received
+	if m.IsPublished() {
+		if err := addCount(tx, m.UserID, "articles_count", -1); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := addCount(tx, m.UserID, "favorites_count", -int(m.FavoritesCount)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewArticleEvent(model.EventArticleDeleted, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	m.DeletedAt = &now
+
+	return nil
+}
+
+// GetDeletedArticles returns the author's articles deleted after t, recently deleted first
+func (s *ArticleStore) GetDeletedArticles(author *model.User, t time.Time, limit, offset int64) ([]model.Article, error) {
+	var as []model.Article
+	err := s.db.Unscoped().Preload("Author").Preload("Tags").
+		Where("user_id = ? AND deleted_at > ?", author.ID, t).
+		Order("deleted_at desc, id desc").
+		Offset(offset).Limit(limit).
+		Find(&as).Error
+	return as, err
+}
+
+// GetDeletedArticleByID finds a deleted article from id
+func (s *ArticleStore) GetDeletedArticleByID(id uint) (*model.Article, error) {
+	var m model.Article
+	err := s.db.Unscoped().Preload("Tags").Preload("Author").
+		Where("deleted_at IS NOT NULL").
+		First(&m, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Restore restores a deleted article with its revisions and the comments deleted with it
+func (s *ArticleStore) Restore(m *model.Article) error {
+	tx := s.db.Begin()
+
+	if err := tx.Unscoped().Model(m).UpdateColumn("deleted_at", nil).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err := tx.Unscoped().Model(&model.ArticleRevision{}).
+		Where("article_id = ?", m.ID).
+		UpdateColumn("deleted_at", nil).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Unscoped().Model(&model.Comment{}).
+		Where("article_id = ? AND deleted_with_article = ?", m.ID, true).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at":           nil,
+			"deleted_with_article": false,
+		}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// count the article and favorites it received again
+	if m.IsPublished() {
+		if err := addCount(tx, m.UserID, "articles_count", 1); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := addCount(tx, m.UserID, "favorites_count", int(m.FavoritesCount)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewArticleEvent(model.EventArticleRestored, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	m.DeletedAt = nil
+
+	return nil
+}
+
+// PurgeDeletedArticles permanently deletes articles deleted before t together with
+// their tags, comments, favorites, bookmarks, reactions and revisions.
+// It returns the number of purged articles.
+func (s *ArticleStore) PurgeDeletedArticles(t time.Time) (int64, error) {
+	var ids []uint
+	err := s.db.Unscoped().Model(&model.Article{}).
+		Where("deleted_at < ?", t).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	tx := s.db.Begin()
+
+	if err := purgeArticles(tx, ids); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+
+	return int64(len(ids)), nil
 }
 
 // IsFavorited returns whether the article is favorited by the user
@@ -109,8 +514,13 @@ func (s *ArticleStore) IsFavorited(a *model.Article, u *model.User) (bool, error
 		return false, nil
 	}
 
+	return isFavorited(s.db, a, u)
+}
+
+// isFavorited returns whether user U favorited article A in the transaction
+func isFavorited(tx *gorm.DB, a *model.Article, u *model.User) (bool, error) {
 	var count int
-	err := s.db.Table("favorite_articles").
+	err := tx.Table("favorite_articles").
 		Where("article_id = ? AND user_id = ?", a.ID, u.ID).
 		Count(&count).Error
 	if err != nil {
@@ -120,58 +530,199 @@ func (s *ArticleStore) IsFavorited(a *model.Article, u *model.User) (bool, error
 	return count > 0, nil
 }
 
-// AddFavorite favorite an article
-func (s *ArticleStore) AddFavorite(a *model.Article, u *model.User) error {
+// AddFavorite favorite an article. It returns false without counting
+// nor writing an event if the user already favorited it.
+func (s *ArticleStore) AddFavorite(a *model.Article, u *model.User) (bool, error) {
 	tx := s.db.Begin()
 
-	err := tx.Model(a).Association("FavoritedUsers").
+	favorited, err := isFavorited(tx, a, u)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if favorited {
+		tx.Rollback()
+		return false, nil
+	}
+
+	err = tx.Model(a).Association("FavoritedUsers").
 		Append(u).Error
 	if err != nil {
 		tx.Rollback()
-		return err
+		return false, err
 	}
 
-	err = tx.Model(a).
+	err = tx.Set("gorm:association_autoupdate", false).Model(a).
 		Update("favorites_count", gorm.Expr("favorites_count + ?", 1)).Error
 	if err != nil {
 		tx.Rollback()
-		return err
+		return false, err
 	}
 
-	tx.Commit()
+	if err := addCount(tx, a.UserID, "favorites_count", 1); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if err := addEvents(tx, model.NewFavoriteEvent(model.EventArticleFavorited, a, u)); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
 	a.FavoritesCount++
 
-	return nil
+	return true, nil
 }
 
-// DeleteFavorite unfavorite an article
-func (s *ArticleStore) DeleteFavorite(a *model.Article, u *model.User) error {
+// DeleteFavorite unfavorite an article. It returns false without counting
+// nor writing an event if the user did not favorite it.
+func (s *ArticleStore) DeleteFavorite(a *model.Article, u *model.User) (bool, error) {
 	tx := s.db.Begin()
 
-	err := tx.Model(a).Association("FavoritedUsers").
+	favorited, err := isFavorited(tx, a, u)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if !favorited {
+		tx.Rollback()
+		return false, nil
+	}
+
+	err = tx.Model(a).Association("FavoritedUsers").
 		Delete(u).Error
 	if err != nil {
 		tx.Rollback()
-		return err
+		return false, err
 	}
 
-	err = tx.Model(a).
+	err = tx.Set("gorm:association_autoupdate", false).Model(a).
 		Update("favorites_count", gorm.Expr("favorites_count - ?", 1)).Error
 	if err != nil {
 		tx.Rollback()
-		return err
+		return false, err
+	}
+
+	if err := addCount(tx, a.UserID, "favorites_count", -1); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if err := addEvents(tx, model.NewFavoriteEvent(model.EventArticleUnfavorited, a, u)); err != nil {
+		tx.Rollback()
+		return false, err
 	}
 
-	tx.Commit()
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
 	a.FavoritesCount--
 
-	return nil
+	return true, nil
+}
+
+// GetFavoritedArticles returns articles the user favorited, oldest first
+func (s *ArticleStore) GetFavoritedArticles(u *model.User, limit, offset int64) ([]model.Article, error) {
+	var as []model.Article
+	err := s.db.Preload("Author").
+		Joins("join favorite_articles on articles.id = favorite_articles.article_id").
+		Where("favorite_articles.user_id = ?", u.ID).
+		Order("articles.id").
+		Offset(offset).Limit(limit).
+		Find(&as).Error
+	return as, err
+}
+
+// IsBookmarked returns whether the article is bookmarked by the user
+func (s *ArticleStore) IsBookmarked(a *model.Article, u *model.User) (bool, error) {
+	if a == nil || u == nil {
+		return false, nil
+	}
+
+	var count int
+	err := s.db.Model(&model.Bookmark{}).
+		Where("article_id = ? AND user_id = ?", a.ID, u.ID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetBookmarkedIDs returns which of the articles are in the user's bookmarks.
+// The user may be nil if not logged in.
+func (s *ArticleStore) GetBookmarkedIDs(u *model.User, articleIDs []uint) (map[uint]bool, error) {
+	bookmarked := map[uint]bool{}
+	if u == nil || len(articleIDs) == 0 {
+		return bookmarked, nil
+	}
+
+	var ids []uint
+	err := s.db.Model(&model.Bookmark{}).
+		Where("user_id = ? AND article_id IN (?)", u.ID, articleIDs).
+		Pluck("article_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		bookmarked[id] = true
+	}
+	return bookmarked, nil
+}
+
+// AddBookmark adds an article to the user's bookmarks.
+// Bookmarking the same article twice is not an error.
+func (s *ArticleStore) AddBookmark(a *model.Article, u *model.User) error {
+	bookmarked, err := s.IsBookmarked(a, u)
+	if err != nil {
+		return err
+	}
+	if bookmarked {
+		return nil
+	}
+
+	return s.db.Create(&model.Bookmark{UserID: u.ID, ArticleID: a.ID}).Error
+}
+
+// DeleteBookmark removes an article from the user's bookmarks
+func (s *ArticleStore) DeleteBookmark(a *model.Article, u *model.User) error {
+	return s.db.Where("article_id = ? AND user_id = ?", a.ID, u.ID).
+		Delete(model.Bookmark{}).Error
+}
+
+// GetBookmarkedArticles returns articles bookmarked by the user, recently bookmarked first
+func (s *ArticleStore) GetBookmarkedArticles(u *model.User, limit, offset int64) ([]model.Article, error) {
+	d := s.db.Preload("Author").Preload("Tags").
+		Joins("join bookmarks on articles.id = bookmarks.article_id").
+		Where("bookmarks.user_id = ?", u.ID).
+		Where("articles.status = ?", model.ArticleStatusPublished).
+		Where("articles.hidden_at IS NULL").
+		Order("bookmarks.created_at desc, bookmarks.id desc")
+
+	// offset query, limit query
+	d = d.Offset(offset).Limit(limit)
+
+	var as []model.Article
+	err := d.Find(&as).Error
+
+	return as, err
 }
 
-// GetTags creates a article tag
+// GetTags returns tags of published articles not hidden by moderation
 func (s *ArticleStore) GetTags() ([]model.Tag, error) {
 	var tags []model.Tag
-	if err := s.db.Find(&tags).Error; err != nil {
+	err := s.db.Select("DISTINCT tags.*").
+		Joins("join article_tags on tags.id = article_tags.tag_id "+
+			"join articles on articles.id = article_tags.article_id").
+		Where("articles.status = ? AND articles.deleted_at IS NULL AND articles.hidden_at IS NULL",
+			model.ArticleStatusPublished).
+		Find(&tags).Error
+	if err != nil {
 		return tags, err
 	}
 	return tags, nil
@@ -179,14 +730,30 @@ func (s *ArticleStore) GetTags() ([]model.Tag, error) {
 
 // CreateComment creates a comment of the article
 func (s *ArticleStore) CreateComment(m *model.Comment) error {
-	return s.db.Create(&m).Error
+	tx := s.db.Begin()
+
+	// not to overwrite author's counters with a stale copy
+	err := tx.Set("gorm:association_autoupdate", false).Create(&m).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewCommentEvent(model.EventCommentCreated, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
 }
 
-// GetComments gets coments of the article
+// GetComments gets coments of the article except ones hidden by moderation.
+// Comments are not returned once the article is deleted.
 func (s *ArticleStore) GetComments(m *model.Article) ([]model.Comment, error) {
 	var cs []model.Comment
 	err := s.db.Preload("Author").
-		Where("article_id = ?", m.ID).
+		Joins("join articles on articles.id = comments.article_id AND articles.deleted_at IS NULL").
+		Where("comments.article_id = ? AND comments.hidden_at IS NULL", m.ID).
 		Find(&cs).Error
 	if err != nil {
 		return cs, err
@@ -194,17 +761,264 @@ func (s *ArticleStore) GetComments(m *model.Article) ([]model.Comment, error) {
 	return cs, nil
 }
 
+// GetAuthoredComments returns comments the author wrote, oldest first
+func (s *ArticleStore) GetAuthoredComments(author *model.User, limit, offset int64) ([]model.Comment, error) {
+	var cs []model.Comment
+	err := s.db.Where("user_id = ?", author.ID).
+		Order("id").
+		Offset(offset).Limit(limit).
+		Find(&cs).Error
+	return cs, err
+}
+
 // GetCommentByID finds an comment from id
 func (s *ArticleStore) GetCommentByID(id uint) (*model.Comment, error) {
 	var m model.Comment
-	err := s.db.Find(&m, id).Error
+	err := s.db.Preload("Author").Find(&m, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &m, nil
 }
 
-// DeleteComment deletes an comment
+// DeleteComment deletes an comment.
+// Its reactions are kept to be restored with it until the comment is purged.
 func (s *ArticleStore) DeleteComment(m *model.Comment) error {
-	return s.db.Delete(m).Error
+	tx := s.db.Begin()
+
+	if err := tx.Delete(m).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewCommentEvent(model.EventCommentDeleted, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// GetDeletedComments returns the author's comments deleted after t, recently deleted first.
+// Comments of deleted articles are not included since they cannot be restored.
+func (s *ArticleStore) GetDeletedComments(author *model.User, t time.Time, limit, offset int64) ([]model.Comment, error) {
+	var cs []model.Comment
+	err := s.db.Unscoped().Preload("Author").
+		Joins("join articles on articles.id = comments.article_id AND articles.deleted_at IS NULL").
+		Where("comments.user_id = ? AND comments.deleted_at > ?", author.ID, t).
+		Order("comments.deleted_at desc, comments.id desc").
+		Offset(offset).Limit(limit).
+		Find(&cs).Error
+	return cs, err
+}
+
+// GetDeletedCommentByID finds a deleted comment from id
+func (s *ArticleStore) GetDeletedCommentByID(id uint) (*model.Comment, error) {
+	var m model.Comment
+	err := s.db.Unscoped().Preload("Author").
+		Where("deleted_at IS NOT NULL").
+		First(&m, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// RestoreComment restores a deleted comment
+func (s *ArticleStore) RestoreComment(m *model.Comment) error {
+	tx := s.db.Begin()
+
+	if err := tx.Unscoped().Model(m).UpdateColumn("deleted_at", nil).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewCommentEvent(model.EventCommentRestored, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	m.DeletedAt = nil
+
+	return nil
+}
+
+// PurgeDeletedComments permanently deletes comments deleted before t with their reactions.
+// It returns the number of purged comments.
+func (s *ArticleStore) PurgeDeletedComments(t time.Time) (int64, error) {
+	var ids []uint
+	err := s.db.Unscoped().Model(&model.Comment{}).
+		Where("deleted_at < ?", t).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	tx := s.db.Begin()
+
+	if err := purgeComments(tx, ids); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+
+	return int64(len(ids)), nil
+}
+
+// purgeArticles permanently deletes articles with their tags, comments, favorites,
+// bookmarks, reactions and revisions
+func purgeArticles(tx *gorm.DB, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var commentIDs []uint
+	err := tx.Unscoped().Model(&model.Comment{}).
+		Where("article_id IN (?)", ids).
+		Pluck("id", &commentIDs).Error
+	if err != nil {
+		return err
+	}
+
+	if err := purgeComments(tx, commentIDs); err != nil {
+		return err
+	}
+
+	for _, q := range []string{
+		"DELETE FROM article_tags WHERE article_id IN (?)",
+		"DELETE FROM favorite_articles WHERE article_id IN (?)",
+	} {
+		if err := tx.Exec(q, ids).Error; err != nil {
+			return err
+		}
+	}
+
+	err = tx.Where("target_type = ? AND target_id IN (?)", model.ReactionTargetArticle, ids).
+		Delete(model.Reaction{}).Error
+	if err != nil {
+		return err
+	}
+
+	for _, m := range []interface{}{&model.Bookmark{}, &model.ArticleRevision{}} {
+		if err := tx.Unscoped().Where("article_id IN (?)", ids).Delete(m).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Unscoped().Where("id IN (?)", ids).Delete(&model.Article{}).Error
+}
+
+// purgeComments permanently deletes comments with their reactions
+func purgeComments(tx *gorm.DB, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	err := tx.Where("target_type = ? AND target_id IN (?)", model.ReactionTargetComment, ids).
+		Delete(model.Reaction{}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Where("id IN (?)", ids).Delete(&model.Comment{}).Error
+}
+
+// AddReaction adds a reaction.
+// Giving the same reaction twice is not an error.
+func (s *ArticleStore) AddReaction(m *model.Reaction) error {
+	var count int
+	err := s.db.Model(&model.Reaction{}).
+		Where("target_type = ? AND target_id = ? AND user_id = ? AND kind = ?",
+			m.TargetType, m.TargetID, m.UserID, m.Kind).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return s.db.Create(m).Error
+}
+
+// DeleteReaction removes a reaction of the user to the target
+func (s *ArticleStore) DeleteReaction(targetType string, targetID uint, u *model.User, kind string) error {
+	return s.db.Where("target_type = ? AND target_id = ? AND user_id = ? AND kind = ?",
+		targetType, targetID, u.ID, kind).
+		Delete(model.Reaction{}).Error
+}
+
+// GetReactionCounts returns the number of reactions of each kind to each target
+func (s *ArticleStore) GetReactionCounts(targetType string, targetIDs []uint) (map[uint]map[string]int32, error) {
+	counts := map[uint]map[string]int32{}
+	if len(targetIDs) == 0 {
+		return counts, nil
+	}
+
+	rows, err := s.db.Model(&model.Reaction{}).
+		Select("target_id, kind, COUNT(*)").
+		Where("target_type = ? AND target_id IN (?)", targetType, targetIDs).
+		Group("target_id, kind").
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id    uint
+			kind  string
+			count int32
+		)
+		if err := rows.Scan(&id, &kind, &count); err != nil {
+			return nil, err
+		}
+		if counts[id] == nil {
+			counts[id] = map[string]int32{}
+		}
+		counts[id][kind] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// GetUserReactions returns kinds of reactions the user gave to each target
+func (s *ArticleStore) GetUserReactions(targetType string, targetIDs []uint, u *model.User) (map[uint][]string, error) {
+	kinds := map[uint][]string{}
+	if len(targetIDs) == 0 || u == nil {
+		return kinds, nil
+	}
+
+	var rs []model.Reaction
+	err := s.db.Where("target_type = ? AND target_id IN (?) AND user_id = ?", targetType, targetIDs, u.ID).
+		Order("id").
+		Find(&rs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rs {
+		kinds[r.TargetID] = append(kinds[r.TargetID], r.Kind)
+	}
+
+	return kinds, nil
+}
+
+// GetReactors returns users who gave the reaction to the target, earliest first
+func (s *ArticleStore) GetReactors(targetType string, targetID uint, kind string, limit, offset int64) ([]model.User, error) {
+	var us []model.User
+	err := s.db.Joins("join reactions on users.id = reactions.user_id").
+		Where("reactions.target_type = ? AND reactions.target_id = ? AND reactions.kind = ?",
+			targetType, targetID, kind).
+		Order("reactions.created_at, reactions.id").
+		Offset(offset).Limit(limit).
+		Find(&us).Error
+	return us, err
 }
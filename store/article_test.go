@@ -0,0 +1,82 @@
+package store
+
+import (
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/raahii/golang-grpc-realworld-example/db"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderMissingBodies(t *testing.T) {
+	d, err := db.NewTestDB()
+	if err != nil {
+		t.Fatalf("failed to initialize database: %v", err)
+	}
+	defer db.DropTestDB(d)
+
+	s := NewArticleStore(d)
+
+	author := model.User{Username: "foo", Email: "foo@example.com", Password: "secret"}
+	if err := d.Create(&author).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	// articles written before bodies were rendered on write
+	var ids []uint
+	for _, body := range []string{"# first\n\nsome *text*", "second", "third"} {
+		a := model.Article{
+			Title:       body,
+			Description: "description",
+			Body:        body,
+			UserID:      author.ID,
+			Status:      model.ArticleStatusPublished,
+		}
+		if err := d.Set("gorm:association_autoupdate", false).Create(&a).Error; err != nil {
+			t.Fatalf("failed to create article: %v", err)
+		}
+		ids = append(ids, a.ID)
+	}
+	err = d.Exec("UPDATE articles SET body_html = NULL, word_count = 0, reading_time = 0 WHERE id IN (?)", ids).Error
+	if err != nil {
+		t.Fatalf("failed to clear rendered bodies: %v", err)
+	}
+
+	// deleted articles are rendered too, to be restored
+	if err := d.Delete(&model.Article{}, ids[2]).Error; err != nil {
+		t.Fatalf("failed to delete article: %v", err)
+	}
+
+	var rendered []uint
+	var after uint
+	for {
+		as, err := s.RenderMissingBodies(after, 2)
+		if !assert.NoError(t, err) || len(as) == 0 {
+			break
+		}
+		for _, a := range as {
+			rendered = append(rendered, a.ID)
+		}
+		after = as[len(as)-1].ID
+	}
+	assert.Equal(t, ids, rendered)
+
+	got, err := s.GetByID(ids[0])
+	if assert.NoError(t, err) {
+		assert.Contains(t, got.BodyHTML, "<em>text</em>")
+		assert.Equal(t, int32(3), got.WordCount)
+		assert.Equal(t, int32(1), got.ReadingTime)
+	}
+
+	deleted, err := s.GetDeletedArticleByID(ids[2])
+	if assert.NoError(t, err) {
+		assert.Contains(t, deleted.BodyHTML, "third")
+	}
+
+	// rendered articles are left as they are
+	as, err := s.RenderMissingBodies(0, 10)
+	if assert.NoError(t, err) {
+		assert.Empty(t, as)
+	}
+}
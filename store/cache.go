@@ -0,0 +1,524 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/cache"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+)
+
+// FirstPageSize is the page size of the global article list which is cached
+const FirstPageSize = 20
+
+const (
+	tagsKey      = "tags"
+	firstPageKey = "articles:first"
+)
+
+func articleKey(id uint) string {
+	return fmt.Sprintf("article:%d", id)
+}
+
+func userKey(id uint) string {
+	return fmt.Sprintf("user:%d", id)
+}
+
+func usernameKey(username string) string {
+	return "username:" + username
+}
+
+// cachedUser is the profile of a user kept in the cache.
+// Credentials and the email address are left out not to copy them to the cache.
+type cachedUser struct {
+	ID             uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Username       string
+	Bio            string
+	Image          string
+	Admin          bool
+	FollowersCount int32
+	FollowingCount int32
+	ArticlesCount  int32
+	FavoritesCount int32
+	SuspendedAt    *time.Time
+	AnonymizedAt   *time.Time
+}
+
+func newCachedUser(u *model.User) *cachedUser {
+	return &cachedUser{
+		ID:             u.ID,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+		Username:       u.Username,
+		Bio:            u.Bio,
+		Image:          u.Image,
+		Admin:          u.Admin,
+		FollowersCount: u.FollowersCount,
+		FollowingCount: u.FollowingCount,
+		ArticlesCount:  u.ArticlesCount,
+		FavoritesCount: u.FavoritesCount,
+		SuspendedAt:    u.SuspendedAt,
+		AnonymizedAt:   u.AnonymizedAt,
+	}
+}
+
+func (c *cachedUser) user() *model.User {
+	u := model.User{
+		Username:       c.Username,
+		Bio:            c.Bio,
+		Image:          c.Image,
+		Admin:          c.Admin,
+		FollowersCount: c.FollowersCount,
+		FollowingCount: c.FollowingCount,
+		ArticlesCount:  c.ArticlesCount,
+		FavoritesCount: c.FavoritesCount,
+		SuspendedAt:    c.SuspendedAt,
+		AnonymizedAt:   c.AnonymizedAt,
+	}
+	u.ID = c.ID
+	u.CreatedAt = c.CreatedAt
+	u.UpdatedAt = c.UpdatedAt
+	return &u
+}
+
+// getCachedUser returns the profile of the user of the id for profiles and authors.
+// It has no credentials nor the email address, so it must not be saved.
+func getCachedUser(ctx context.Context, c *cache.Cache, db *gorm.DB, id uint) (*model.User, error) {
+	var m cachedUser
+	err := c.Fetch(ctx, userKey(id), &m, func() (interface{}, error) {
+		var u model.User
+		if err := db.Find(&u, id).Error; err != nil {
+			return nil, err
+		}
+		return newCachedUser(&u), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.user(), nil
+}
+
+// setCachedAuthors sets authors of the articles from the cache
+func setCachedAuthors(ctx context.Context, c *cache.Cache, db *gorm.DB, as []model.Article) error {
+	for i := range as {
+		u, err := getCachedUser(ctx, c, db, as[i].UserID)
+		if err != nil {
+			return err
+		}
+		as[i].Author = *u
+	}
+	return nil
+}
+
+// invalidateArticles invalidates the articles and their authors,
+// with the tags and the first page which they may be listed in
+func invalidateArticles(ctx context.Context, c *cache.Cache, as ...model.Article) {
+	keys := []string{tagsKey, firstPageKey}
+	for _, a := range as {
+		keys = append(keys, articleKey(a.ID))
+		if a.UserID != 0 {
+			keys = append(keys, userKey(a.UserID))
+		}
+	}
+	c.Delete(ctx, keys...)
+}
+
+// invalidateUsers invalidates profiles of the users
+func invalidateUsers(ctx context.Context, c *cache.Cache, ids ...uint) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, userKey(id))
+	}
+	c.Delete(ctx, keys...)
+}
+
+// CachedArticleStore is an ArticleStore which caches articles, tags and
+// the first page of the global article list. Authors are cached separately
+// so that changes of their profiles do not go stale in the articles.
+type CachedArticleStore struct {
+	*ArticleStore
+	c   *cache.Cache
+	ctx context.Context
+}
+
+// NewCachedArticleStore returns a new CachedArticleStore
+func NewCachedArticleStore(s *ArticleStore, c *cache.Cache) *CachedArticleStore {
+	return &CachedArticleStore{
+		ArticleStore: s,
+		c:            c,
+		ctx:          context.Background(),
+	}
+}
+
+// WithContext returns the CachedArticleStore whose queries are traced in ctx
+func (s *CachedArticleStore) WithContext(ctx context.Context) *CachedArticleStore {
+	return &CachedArticleStore{
+		ArticleStore: s.ArticleStore.WithContext(ctx),
+		c:            s.c,
+		ctx:          ctx,
+	}
+}
+
+// GetByID finds an article from id
+func (s *CachedArticleStore) GetByID(id uint) (*model.Article, error) {
+	var m model.Article
+	err := s.c.Fetch(s.ctx, articleKey(id), &m, func() (interface{}, error) {
+		a, err := s.ArticleStore.GetByID(id)
+		if err != nil {
+			return nil, err
+		}
+		a.Author = model.User{}
+		return a, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := getCachedUser(s.ctx, s.c, s.db, m.UserID)
+	if err != nil {
+		return nil, err
+	}
+	m.Author = *author
+
+	return &m, nil
+}
+
+// GetArticles get global articles. Only the first page without any filters is cached.
+func (s *CachedArticleStore) GetArticles(tagName, username string, favoritedBy *model.User, hiddenUserIDs []uint, limit, offset int64) ([]model.Article, error) {
+	if tagName != "" || username != "" || favoritedBy != nil || len(hiddenUserIDs) > 0 ||
+		limit != FirstPageSize || offset != 0 {
+		return s.ArticleStore.GetArticles(tagName, username, favoritedBy, hiddenUserIDs, limit, offset)
+	}
+
+	var as []model.Article
+	err := s.c.Fetch(s.ctx, firstPageKey, &as, func() (interface{}, error) {
+		as, err := s.ArticleStore.GetArticles("", "", nil, nil, limit, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range as {
+			as[i].Author = model.User{}
+		}
+		return as, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setCachedAuthors(s.ctx, s.c, s.db, as); err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+// GetTags returns tags of published articles not hidden by moderation
+func (s *CachedArticleStore) GetTags() ([]model.Tag, error) {
+	var tags []model.Tag
+	err := s.c.Fetch(s.ctx, tagsKey, &tags, func() (interface{}, error) {
+		return s.ArticleStore.GetTags()
+	})
+	return tags, err
+}
+
+// Create creates an article with its first revision
+func (s *CachedArticleStore) Create(m *model.Article) error {
+	if err := s.ArticleStore.Create(m); err != nil {
+		return err
+	}
+	invalidateArticles(s.ctx, s.c, *m)
+	return nil
+}
+
+// Update updates an article
+func (s *CachedArticleStore) Update(m *model.Article) error {
+	if err := s.ArticleStore.Update(m); err != nil {
+		return err
+	}
+	invalidateArticles(s.ctx, s.c, *m)
+	return nil
+}
+
+// RestoreRevision restores the article to the revision
+func (s *CachedArticleStore) RestoreRevision(m *model.Article, r *model.ArticleRevision) error {
+	if err := s.ArticleStore.RestoreRevision(m, r); err != nil {
+		return err
+	}
+	invalidateArticles(s.ctx, s.c, *m)
+	return nil
+}
+
+// RenderMissingBodies renders bodies of articles written before bodies
+// were rendered, and invalidates them
+func (s *CachedArticleStore) RenderMissingBodies(after uint, limit int64) ([]model.Article, error) {
+	as, err := s.ArticleStore.RenderMissingBodies(after, limit)
+	if err != nil {
+		return as, err
+	}
+	if len(as) > 0 {
+		invalidateArticles(s.ctx, s.c, as...)
+	}
+	return as, nil
+}
+
+// PublishDueArticles publishes scheduled articles due at t
+func (s *CachedArticleStore) PublishDueArticles(t time.Time) ([]model.Article, error) {
+	as, err := s.ArticleStore.PublishDueArticles(t)
+	if err != nil {
+		return as, err
+	}
+	if len(as) > 0 {
+		invalidateArticles(s.ctx, s.c, as...)
+	}
+	return as, nil
+}
+
+// Delete deletes an article with its comments and revisions
+func (s *CachedArticleStore) Delete(m *model.Article) error {
+	if err := s.ArticleStore.Delete(m); err != nil {
+		return err
+	}
+	invalidateArticles(s.ctx, s.c, *m)
+	return nil
+}
+
+// Restore restores a deleted article
+func (s *CachedArticleStore) Restore(m *model.Article) error {
+	if err := s.ArticleStore.Restore(m); err != nil {
+		return err
+	}
+	invalidateArticles(s.ctx, s.c, *m)
+	return nil
+}
+
+// AddFavorite favorite an article
+func (s *CachedArticleStore) AddFavorite(a *model.Article, u *model.User) (bool, error) {
+	changed, err := s.ArticleStore.AddFavorite(a, u)
+	if err != nil || !changed {
+		return changed, err
+	}
+	invalidateArticles(s.ctx, s.c, *a)
+	return true, nil
+}
+
+// DeleteFavorite unfavorite an article
+func (s *CachedArticleStore) DeleteFavorite(a *model.Article, u *model.User) (bool, error) {
+	changed, err := s.ArticleStore.DeleteFavorite(a, u)
+	if err != nil || !changed {
+		return changed, err
+	}
+	invalidateArticles(s.ctx, s.c, *a)
+	return true, nil
+}
+
+// CachedUserStore is a UserStore which caches profiles looked up by username.
+// Users looked up by id are not cached, as they are the authenticated users.
+type CachedUserStore struct {
+	*UserStore
+	c   *cache.Cache
+	ctx context.Context
+}
+
+// NewCachedUserStore returns a new CachedUserStore
+func NewCachedUserStore(s *UserStore, c *cache.Cache) *CachedUserStore {
+	return &CachedUserStore{
+		UserStore: s,
+		c:         c,
+		ctx:       context.Background(),
+	}
+}
+
+// WithContext returns the CachedUserStore whose queries are traced in ctx
+func (s *CachedUserStore) WithContext(ctx context.Context) *CachedUserStore {
+	return &CachedUserStore{
+		UserStore: s.UserStore.WithContext(ctx),
+		c:         s.c,
+		ctx:       ctx,
+	}
+}
+
+// GetByUsername finds a user from username. Users who deleted their accounts are not found.
+func (s *CachedUserStore) GetByUsername(username string) (*model.User, error) {
+	var id uint
+	err := s.c.Fetch(s.ctx, usernameKey(username), &id, func() (interface{}, error) {
+		u, err := s.UserStore.GetByUsername(username)
+		if err != nil {
+			return nil, err
+		}
+		return u.ID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := getCachedUser(s.ctx, s.c, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the username was changed or the account was deleted
+	if u.Username != username || u.IsAnonymized() {
+		s.c.Delete(s.ctx, usernameKey(username))
+		return s.UserStore.GetByUsername(username)
+	}
+
+	return u, nil
+}
+
+// Update update all of user fields except counters
+func (s *CachedUserStore) Update(m *model.User) error {
+	if err := s.UserStore.Update(m); err != nil {
+		return err
+	}
+	invalidateUsers(s.ctx, s.c, m.ID)
+	return nil
+}
+
+// Follow create follow relationship to User B from user A
+func (s *CachedUserStore) Follow(a *model.User, b *model.User) error {
+	if err := s.UserStore.Follow(a, b); err != nil {
+		return err
+	}
+	invalidateUsers(s.ctx, s.c, a.ID, b.ID)
+	return nil
+}
+
+// Unfollow delete follow relationship to User B from user A
+func (s *CachedUserStore) Unfollow(a *model.User, b *model.User) error {
+	if err := s.UserStore.Unfollow(a, b); err != nil {
+		return err
+	}
+	invalidateUsers(s.ctx, s.c, a.ID, b.ID)
+	return nil
+}
+
+// Block blocks user B by user A, removing follows between them
+func (s *CachedUserStore) Block(a *model.User, b *model.User) error {
+	if err := s.UserStore.Block(a, b); err != nil {
+		return err
+	}
+	invalidateUsers(s.ctx, s.c, a.ID, b.ID)
+	return nil
+}
+
+// ReconcileCounters recounts counters of every user, and invalidates all
+// profiles if any of them are fixed
+func (s *CachedUserStore) ReconcileCounters() (int64, error) {
+	n, err := s.UserStore.ReconcileCounters()
+	if err != nil || n == 0 {
+		return n, err
+	}
+
+	var ids []uint
+	if err := s.db.Model(&model.User{}).Pluck("id", &ids).Error; err != nil {
+		return n, err
+	}
+	invalidateUsers(s.ctx, s.c, ids...)
+
+	return n, nil
+}
+
+// DeleteAccount deletes the user's account, and invalidates the users and
+// the articles whose counters are changed along with it
+func (s *CachedUserStore) DeleteAccount(m *model.User, removeContents bool) error {
+	var userIDs, articleIDs, authorIDs []uint
+	err := s.db.Table("follows").Where("from_user_id = ?", m.ID).Pluck("to_user_id", &userIDs).Error
+	if err != nil {
+		return err
+	}
+	var followerIDs []uint
+	err = s.db.Table("follows").Where("to_user_id = ?", m.ID).Pluck("from_user_id", &followerIDs).Error
+	if err != nil {
+		return err
+	}
+	err = s.db.Table("favorite_articles").Where("user_id = ?", m.ID).Pluck("article_id", &articleIDs).Error
+	if err != nil {
+		return err
+	}
+	if len(articleIDs) > 0 {
+		err := s.db.Unscoped().Model(&model.Article{}).Where("id in (?)", articleIDs).Pluck("user_id", &authorIDs).Error
+		if err != nil {
+			return err
+		}
+	}
+	if removeContents {
+		var ids []uint
+		err := s.db.Unscoped().Model(&model.Article{}).Where("user_id = ?", m.ID).Pluck("id", &ids).Error
+		if err != nil {
+			return err
+		}
+		articleIDs = append(articleIDs, ids...)
+	}
+
+	if err := s.UserStore.DeleteAccount(m, removeContents); err != nil {
+		return err
+	}
+
+	userIDs = append(append(append(userIDs, followerIDs...), authorIDs...), m.ID)
+	invalidateUsers(s.ctx, s.c, userIDs...)
+
+	as := make([]model.Article, 0, len(articleIDs))
+	for _, id := range articleIDs {
+		as = append(as, model.Article{Model: gorm.Model{ID: id}})
+	}
+	invalidateArticles(s.ctx, s.c, as...)
+
+	return nil
+}
+
+// CachedReportStore is a ReportStore which invalidates cached contents
+// hidden or unhidden by moderation
+type CachedReportStore struct {
+	*ReportStore
+	c   *cache.Cache
+	ctx context.Context
+}
+
+// NewCachedReportStore returns a new CachedReportStore
+func NewCachedReportStore(s *ReportStore, c *cache.Cache) *CachedReportStore {
+	return &CachedReportStore{
+		ReportStore: s,
+		c:           c,
+		ctx:         context.Background(),
+	}
+}
+
+// WithContext returns the CachedReportStore whose queries are traced in ctx
+func (s *CachedReportStore) WithContext(ctx context.Context) *CachedReportStore {
+	return &CachedReportStore{
+		ReportStore: s.ReportStore.WithContext(ctx),
+		c:           s.c,
+		ctx:         ctx,
+	}
+}
+
+// Create creates a report, and hides the target when it is reported
+// from autoHideThreshold distinct users
+func (s *CachedReportStore) Create(m *model.Report, autoHideThreshold int) (bool, error) {
+	hidden, err := s.ReportStore.Create(m, autoHideThreshold)
+	if err != nil {
+		return hidden, err
+	}
+	if hidden && m.TargetType == model.ReportTargetArticle {
+		invalidateArticles(s.ctx, s.c, model.Article{Model: gorm.Model{ID: m.TargetID}})
+	}
+	return hidden, nil
+}
+
+// Resolve resolves open reports on the target with the action taken by the resolver
+func (s *CachedReportStore) Resolve(targetType string, targetID, authorID uint, action string, resolver *model.User, t time.Time) (bool, error) {
+	held, err := s.ReportStore.Resolve(targetType, targetID, authorID, action, resolver, t)
+	if err != nil {
+		return held, err
+	}
+	if targetType == model.ReportTargetArticle {
+		invalidateArticles(s.ctx, s.c, model.Article{Model: gorm.Model{ID: targetID}})
+	}
+	if action == model.ModerationSuspend {
+		invalidateUsers(s.ctx, s.c, authorID)
+	}
+	return held, nil
+}
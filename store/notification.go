@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
+)
+
+// NotificationStore is data access struct for notification
+type NotificationStore struct {
+	db *gorm.DB
+}
+
+// NewNotificationStore returns a new NotificationStore
+func NewNotificationStore(db *gorm.DB) *NotificationStore {
+	return &NotificationStore{
+		db: db,
+	}
+}
+
+// WithContext returns the NotificationStore whose queries are traced in ctx
+func (s *NotificationStore) WithContext(ctx context.Context) *NotificationStore {
+	return &NotificationStore{
+		db: tracing.WithContext(s.db, ctx),
+	}
+}
+
+// Create creates a notification
+func (s *NotificationStore) Create(m *model.Notification) error {
+	return s.db.Create(m).Error
+}
+
+// GetByID finds a notification of the user from id
+func (s *NotificationStore) GetByID(user *model.User, id uint) (*model.Notification, error) {
+	var m model.Notification
+	err := s.db.Preload("Actor").
+		Where("user_id = ?", user.ID).
+		First(&m, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// GetNotifications returns notifications of the user, newest first
+func (s *NotificationStore) GetNotifications(user *model.User, unreadOnly bool, limit, offset int64) ([]model.Notification, error) {
+	d := s.db.Preload("Actor").
+		Where("user_id = ?", user.ID)
+
+	if unreadOnly {
+		d = d.Where("read_at IS NULL")
+	}
+
+	var ns []model.Notification
+	err := d.Order("created_at desc, id desc").
+		Offset(offset).Limit(limit).
+		Find(&ns).Error
+
+	return ns, err
+}
+
+// CountUnread returns the number of unread notifications of the user
+func (s *NotificationStore) CountUnread(user *model.User) (int, error) {
+	var count int
+	err := s.db.Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", user.ID).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkRead marks a notification as read
+func (s *NotificationStore) MarkRead(m *model.Notification, t time.Time) error {
+	if m.IsRead() {
+		return nil
+	}
+	m.ReadAt = &t
+	return s.db.Model(m).Update("read_at", t).Error
+}
+
+// MarkAllRead marks all unread notifications of the user as read
+func (s *NotificationStore) MarkAllRead(user *model.User, t time.Time) (int64, error) {
+	d := s.db.Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", user.ID).
+		Update("read_at", t)
+	return d.RowsAffected, d.Error
+}
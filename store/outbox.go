@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
+)
+
+// OutboxStore is data access struct for outbox events
+type OutboxStore struct {
+	db *gorm.DB
+}
+
+// NewOutboxStore returns a new OutboxStore
+func NewOutboxStore(db *gorm.DB) *OutboxStore {
+	return &OutboxStore{
+		db: db,
+	}
+}
+
+// WithContext returns the OutboxStore whose queries are traced in ctx
+func (s *OutboxStore) WithContext(ctx context.Context) *OutboxStore {
+	return &OutboxStore{
+		db: tracing.WithContext(s.db, ctx),
+	}
+}
+
+// GetPendingEvents returns events not dispatched yet which are due at t in the
+// order they occurred. Events behind an event of the same aggregate waiting for
+// its retry are not returned, to keep them in order.
+func (s *OutboxStore) GetPendingEvents(t time.Time, limit int64) ([]model.OutboxEvent, error) {
+	var es []model.OutboxEvent
+	err := s.db.Where("dispatched_at IS NULL AND (next_attempt_at IS NULL OR next_attempt_at <= ?)", t).
+		Where(`NOT EXISTS (SELECT 1 FROM outbox_events AS w
+			WHERE w.aggregate_type = outbox_events.aggregate_type AND w.aggregate_id = outbox_events.aggregate_id
+			AND w.id < outbox_events.id AND w.dispatched_at IS NULL AND w.next_attempt_at > ?)`, t).
+		Order("id").
+		Limit(limit).
+		Find(&es).Error
+	return es, err
+}
+
+// MarkDispatched marks an event as dispatched
+func (s *OutboxStore) MarkDispatched(m *model.OutboxEvent, t time.Time) error {
+	m.DispatchedAt = &t
+	return s.db.Model(m).Update("dispatched_at", t).Error
+}
+
+// MarkFailed records a failed attempt to dispatch an event, which is retried at next
+func (s *OutboxStore) MarkFailed(m *model.OutboxEvent, next time.Time) error {
+	m.Attempts++
+	m.NextAttemptAt = &next
+	return s.db.Model(m).UpdateColumns(map[string]interface{}{
+		"attempts":        m.Attempts,
+		"next_attempt_at": next,
+	}).Error
+}
+
+// DeleteDispatchedEvents deletes events dispatched before t
+func (s *OutboxStore) DeleteDispatchedEvents(t time.Time) (int64, error) {
+	res := s.db.Where("dispatched_at < ?", t).Delete(&model.OutboxEvent{})
+	return res.RowsAffected, res.Error
+}
+
+// addEvents writes events to the outbox in the transaction of the change
+func addEvents(tx *gorm.DB, es ...*model.OutboxEvent) error {
+	for _, e := range es {
+		if err := tx.Create(e).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
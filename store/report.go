@@ -0,0 +1,241 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
+)
+
+// ReportStore is data access struct for reports of contents
+type ReportStore struct {
+	db *gorm.DB
+}
+
+// NewReportStore returns a new ReportStore
+func NewReportStore(db *gorm.DB) *ReportStore {
+	return &ReportStore{
+		db: db,
+	}
+}
+
+// WithContext returns the ReportStore whose queries are traced in ctx
+func (s *ReportStore) WithContext(ctx context.Context) *ReportStore {
+	return &ReportStore{
+		db: tracing.WithContext(s.db, ctx),
+	}
+}
+
+// ReportTarget is a reported content with its open reports
+type ReportTarget struct {
+	TargetType string
+	TargetID   uint
+	Reports    []model.Report
+}
+
+// targetModel returns the model of the reported content type
+func targetModel(targetType string) (interface{}, error) {
+	switch targetType {
+	case model.ReportTargetArticle:
+		return &model.Article{}, nil
+	case model.ReportTargetComment:
+		return &model.Comment{}, nil
+	}
+	return nil, fmt.Errorf("unknown report target type: %s", targetType)
+}
+
+// HasReported returns whether the user has an open report on the target
+func (s *ReportStore) HasReported(targetType string, targetID uint, u *model.User) (bool, error) {
+	var count int
+	err := s.db.Model(&model.Report{}).
+		Where("target_type = ? AND target_id = ? AND reporter_id = ? AND status = ?",
+			targetType, targetID, u.ID, model.ReportStatusOpen).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Create creates a report and hides the target once it gets open reports
+// from autoHideThreshold distinct users. It returns whether the target got hidden.
+// Zero autoHideThreshold disables hiding automatically.
+func (s *ReportStore) Create(m *model.Report, autoHideThreshold int) (bool, error) {
+	target, err := targetModel(m.TargetType)
+	if err != nil {
+		return false, err
+	}
+
+	m.Status = model.ReportStatusOpen
+
+	tx := s.db.Begin()
+
+	if err := tx.Set("gorm:save_associations", false).Create(m).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if autoHideThreshold <= 0 {
+		return false, tx.Commit().Error
+	}
+
+	var count int
+	err = tx.Model(&model.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?",
+			m.TargetType, m.TargetID, model.ReportStatusOpen).
+		Select("COUNT(DISTINCT reporter_id)").
+		Count(&count).Error
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	var hidden bool
+	if count >= autoHideThreshold {
+		d := tx.Model(target).
+			Where("id = ? AND hidden_at IS NULL", m.TargetID).
+			UpdateColumn("hidden_at", time.Now())
+		if d.Error != nil {
+			tx.Rollback()
+			return false, d.Error
+		}
+		hidden = d.RowsAffected > 0
+	}
+
+	return hidden, tx.Commit().Error
+}
+
+// GetQueue returns reported contents with their open reports, earliest reported first
+func (s *ReportStore) GetQueue(limit, offset int64) ([]ReportTarget, error) {
+	rows, err := s.db.Model(&model.Report{}).
+		Select("target_type, target_id").
+		Where("status = ?", model.ReportStatusOpen).
+		Group("target_type, target_id").
+		Order("MIN(created_at), MIN(id)").
+		Offset(offset).Limit(limit).
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ts := []ReportTarget{}
+	index := map[string]int{}
+	for rows.Next() {
+		var t ReportTarget
+		if err := rows.Scan(&t.TargetType, &t.TargetID); err != nil {
+			return nil, err
+		}
+		index[fmt.Sprintf("%s:%d", t.TargetType, t.TargetID)] = len(ts)
+		ts = append(ts, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ts) == 0 {
+		return ts, nil
+	}
+
+	// open reports of the targets
+	conds := make([]string, 0, len(ts))
+	args := make([]interface{}, 0, 2*len(ts))
+	for _, t := range ts {
+		conds = append(conds, "(target_type = ? AND target_id = ?)")
+		args = append(args, t.TargetType, t.TargetID)
+	}
+
+	var rs []model.Report
+	err = s.db.Preload("Reporter").
+		Where("status = ?", model.ReportStatusOpen).
+		Where(strings.Join(conds, " OR "), args...).
+		Order("id").
+		Find(&rs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rs {
+		if i, ok := index[fmt.Sprintf("%s:%d", r.TargetType, r.TargetID)]; ok {
+			ts[i].Reports = append(ts[i].Reports, r)
+		}
+	}
+
+	return ts, nil
+}
+
+// Resolve resolves open reports on the target with the action taken by the resolver.
+// Dismissing reports unhides the target, hiding the target keeps it from the public
+// and suspending the author hides the target as well.
+// It returns whether the target was held by the content filter.
+func (s *ReportStore) Resolve(targetType string, targetID, authorID uint, action string, resolver *model.User, t time.Time) (bool, error) {
+	target, err := targetModel(targetType)
+	if err != nil {
+		return false, err
+	}
+
+	tx := s.db.Begin()
+
+	var held int
+	err = tx.Model(&model.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ? AND reporter_id = 0",
+			targetType, targetID, model.ReportStatusOpen).
+		Count(&held).Error
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	d := tx.Model(&model.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?",
+			targetType, targetID, model.ReportStatusOpen).
+		Updates(map[string]interface{}{
+			"status":      model.ReportStatusResolved,
+			"resolution":  action,
+			"resolver_id": resolver.ID,
+			"resolved_at": t,
+		})
+	if d.Error != nil {
+		tx.Rollback()
+		return false, d.Error
+	}
+	if d.RowsAffected == 0 {
+		tx.Rollback()
+		return false, gorm.ErrRecordNotFound
+	}
+
+	var hiddenAt interface{}
+	switch action {
+	case model.ModerationDismiss:
+		hiddenAt = nil
+	case model.ModerationHide, model.ModerationSuspend:
+		hiddenAt = t
+	default:
+		tx.Rollback()
+		return false, fmt.Errorf("unknown moderation action: %s", action)
+	}
+
+	err = tx.Model(target).
+		Where("id = ?", targetID).
+		UpdateColumn("hidden_at", hiddenAt).Error
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if action == model.ModerationSuspend {
+		err := tx.Model(&model.User{}).
+			Where("id = ? AND suspended_at IS NULL", authorID).
+			UpdateColumn("suspended_at", t).Error
+		if err != nil {
+			tx.Rollback()
+			return false, err
+		}
+	}
+
+	return held > 0, tx.Commit().Error
+}
@@ -1,8 +1,12 @@
 package store
 
 import (
+	"context"
+	"time"
+
 	"github.com/jinzhu/gorm"
 	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
 )
 
 // UserStore is data access struct for user
@@ -17,6 +21,13 @@ func NewUserStore(db *gorm.DB) *UserStore {
 	}
 }
 
+// WithContext returns the UserStore whose queries are traced in ctx
+func (s *UserStore) WithContext(ctx context.Context) *UserStore {
+	return &UserStore{
+		db: tracing.WithContext(s.db, ctx),
+	}
+}
+
 // GetByEmail finds a user from email
 func (s *UserStore) GetByEmail(email string) (*model.User, error) {
 	var m model.User
@@ -35,10 +46,10 @@ func (s *UserStore) GetByID(id uint) (*model.User, error) {
 	return &m, nil
 }
 
-// GetByUsername finds a user from username
+// GetByUsername finds a user from username. Users who deleted their accounts are not found.
 func (s *UserStore) GetByUsername(username string) (*model.User, error) {
 	var m model.User
-	if err := s.db.Where("username = ?", username).First(&m).Error; err != nil {
+	if err := s.db.Where("username = ? AND anonymized_at IS NULL", username).First(&m).Error; err != nil {
 		return nil, err
 	}
 	return &m, nil
@@ -49,9 +60,12 @@ func (s *UserStore) Create(m *model.User) error {
 	return s.db.Create(m).Error
 }
 
-// Update update all of user fields
+// Update update all of user fields except counters,
+// which are updated only along with what they count
 func (s *UserStore) Update(m *model.User) error {
-	return s.db.Model(m).Update(m).Error
+	return s.db.Model(m).
+		Omit("followers_count", "following_count", "articles_count", "favorites_count").
+		Update(m).Error
 }
 
 // IsFollowing returns whether user A follows user B or not
@@ -72,13 +86,112 @@ func (s *UserStore) IsFollowing(a *model.User, b *model.User) (bool, error) {
 }
 
 // Follow create follow relashionship to User B from user A
+// and counts it on both users
 func (s *UserStore) Follow(a *model.User, b *model.User) error {
-	return s.db.Model(a).Association("Follows").Append(b).Error
+	tx := s.db.Begin()
+
+	following, err := isFollowing(tx, a, b)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if following {
+		tx.Rollback()
+		return nil
+	}
+
+	if err := tx.Model(a).Association("Follows").Append(b).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addFollowCounts(tx, a, b, 1); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewFollowEvent(model.EventUserFollowed, a, b)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	a.FollowingCount++
+	b.FollowersCount++
+
+	return nil
 }
 
 // Unfollow delete follow relashionship to User B from user A
+// and uncounts it on both users
 func (s *UserStore) Unfollow(a *model.User, b *model.User) error {
-	return s.db.Model(a).Association("Follows").Delete(b).Error
+	tx := s.db.Begin()
+
+	following, err := isFollowing(tx, a, b)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if !following {
+		tx.Rollback()
+		return nil
+	}
+
+	if err := unfollow(tx, a, b); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	a.FollowingCount--
+	b.FollowersCount--
+
+	return nil
+}
+
+// isFollowing returns whether user A follows user B in the transaction
+func isFollowing(tx *gorm.DB, a *model.User, b *model.User) (bool, error) {
+	var count int
+	err := tx.Table("follows").
+		Where("from_user_id = ? AND to_user_id = ?", a.ID, b.ID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// unfollow deletes follow relationship to user B from user A in the transaction
+func unfollow(tx *gorm.DB, a *model.User, b *model.User) error {
+	if err := tx.Model(a).Association("Follows").Delete(b).Error; err != nil {
+		return err
+	}
+
+	if err := addFollowCounts(tx, a, b, -1); err != nil {
+		return err
+	}
+
+	return addEvents(tx, model.NewFollowEvent(model.EventUserUnfollowed, a, b))
+}
+
+// addFollowCounts adds delta to following count of user A and followers count of user B
+func addFollowCounts(tx *gorm.DB, a *model.User, b *model.User, delta int) error {
+	if err := addCount(tx, a.ID, "following_count", delta); err != nil {
+		return err
+	}
+	return addCount(tx, b.ID, "followers_count", delta)
+}
+
+// addCount adds delta to the counter column of the user
+func addCount(tx *gorm.DB, userID uint, column string, delta int) error {
+	return tx.Model(&model.User{}).
+		Where("id = ?", userID).
+		UpdateColumn(column, gorm.Expr(column+" + ?", delta)).Error
 }
 
 // GetFollowingUserIDs returns user ids current user follows
@@ -101,3 +214,357 @@ func (s *UserStore) GetFollowingUserIDs(m *model.User) ([]uint, error) {
 
 	return ids, nil
 }
+
+// GetFollowers returns users following the user
+func (s *UserStore) GetFollowers(m *model.User, limit, offset int64) ([]model.User, error) {
+	var us []model.User
+	err := s.db.Joins("join follows on users.id = follows.from_user_id").
+		Where("follows.to_user_id = ?", m.ID).
+		Order("users.username").
+		Offset(offset).Limit(limit).
+		Find(&us).Error
+	return us, err
+}
+
+// GetFollowing returns users the user follows
+func (s *UserStore) GetFollowing(m *model.User, limit, offset int64) ([]model.User, error) {
+	var us []model.User
+	err := s.db.Joins("join follows on users.id = follows.to_user_id").
+		Where("follows.from_user_id = ?", m.ID).
+		Order("users.username").
+		Offset(offset).Limit(limit).
+		Find(&us).Error
+	return us, err
+}
+
+// IsBlocking returns whether user A blocks user B or not
+func (s *UserStore) IsBlocking(a *model.User, b *model.User) (bool, error) {
+	if a == nil || b == nil {
+		return false, nil
+	}
+
+	var count int
+	err := s.db.Table("blocks").
+		Where("from_user_id = ? AND to_user_id = ?", a.ID, b.ID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// IsBlockedBetween returns whether either of user A and user B blocks the other
+func (s *UserStore) IsBlockedBetween(a *model.User, b *model.User) (bool, error) {
+	if a == nil || b == nil {
+		return false, nil
+	}
+
+	var count int
+	err := s.db.Table("blocks").
+		Where("(from_user_id = ? AND to_user_id = ?) OR (from_user_id = ? AND to_user_id = ?)",
+			a.ID, b.ID, b.ID, a.ID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Block create block relationship to user B from user A.
+// Follow relationships between them are removed in either direction.
+func (s *UserStore) Block(a *model.User, b *model.User) error {
+	tx := s.db.Begin()
+
+	if err := tx.Model(a).Association("Blocks").Append(b).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	var unfollowed [][2]*model.User
+	for _, f := range [][2]*model.User{{a, b}, {b, a}} {
+		following, err := isFollowing(tx, f[0], f[1])
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if !following {
+			continue
+		}
+
+		if err := unfollow(tx, f[0], f[1]); err != nil {
+			tx.Rollback()
+			return err
+		}
+		unfollowed = append(unfollowed, f)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	for _, f := range unfollowed {
+		f[0].FollowingCount--
+		f[1].FollowersCount--
+	}
+
+	return nil
+}
+
+// Unblock delete block relationship to user B from user A
+func (s *UserStore) Unblock(a *model.User, b *model.User) error {
+	return s.db.Model(a).Association("Blocks").Delete(b).Error
+}
+
+// IsMuting returns whether user A mutes user B or not
+func (s *UserStore) IsMuting(a *model.User, b *model.User) (bool, error) {
+	if a == nil || b == nil {
+		return false, nil
+	}
+
+	var count int
+	err := s.db.Table("mutes").
+		Where("from_user_id = ? AND to_user_id = ?", a.ID, b.ID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Mute create mute relationship to user B from user A
+func (s *UserStore) Mute(a *model.User, b *model.User) error {
+	return s.db.Model(a).Association("Mutes").Append(b).Error
+}
+
+// Unmute delete mute relationship to user B from user A
+func (s *UserStore) Unmute(a *model.User, b *model.User) error {
+	return s.db.Model(a).Association("Mutes").Delete(b).Error
+}
+
+// GetBlockedUsers returns users the user blocks
+func (s *UserStore) GetBlockedUsers(m *model.User, limit, offset int64) ([]model.User, error) {
+	var us []model.User
+	err := s.db.Joins("join blocks on users.id = blocks.to_user_id").
+		Where("blocks.from_user_id = ?", m.ID).
+		Order("users.username").
+		Offset(offset).Limit(limit).
+		Find(&us).Error
+	return us, err
+}
+
+// GetMutedUsers returns users the user mutes
+func (s *UserStore) GetMutedUsers(m *model.User, limit, offset int64) ([]model.User, error) {
+	var us []model.User
+	err := s.db.Joins("join mutes on users.id = mutes.to_user_id").
+		Where("mutes.from_user_id = ?", m.ID).
+		Order("users.username").
+		Offset(offset).Limit(limit).
+		Find(&us).Error
+	return us, err
+}
+
+// GetHiddenUserIDs returns ids of users whose contents are hidden from the user,
+// that is users the user mutes or blocks and users blocking the user
+func (s *UserStore) GetHiddenUserIDs(m *model.User) ([]uint, error) {
+	if m == nil {
+		return []uint{}, nil
+	}
+
+	rows, err := s.db.Raw(
+		"SELECT to_user_id FROM mutes WHERE from_user_id = ? "+
+			"UNION SELECT to_user_id FROM blocks WHERE from_user_id = ? "+
+			"UNION SELECT from_user_id FROM blocks WHERE to_user_id = ?",
+		m.ID, m.ID, m.ID).Rows()
+	if err != nil {
+		return []uint{}, err
+	}
+	defer rows.Close()
+
+	var ids []uint
+	for rows.Next() {
+		var id uint
+		rows.Scan(&id)
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// DeleteAccount deletes the user's account in a transaction. The user is anonymized
+// rather than deleted so that the contents kept remain attributed to a deleted user.
+// Follows, blocks, mutes, favorites, bookmarks, reactions and notifications of the user
+// are removed, and so are the user's articles and comments if removeContents is true.
+func (s *UserStore) DeleteAccount(m *model.User, removeContents bool) error {
+	tx := s.db.Begin()
+
+	if err := deleteFollows(tx, m); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, q := range []string{
+		"DELETE FROM blocks WHERE from_user_id = ? OR to_user_id = ?",
+		"DELETE FROM mutes WHERE from_user_id = ? OR to_user_id = ?",
+	} {
+		if err := tx.Exec(q, m.ID, m.ID).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := deleteFavorites(tx, m); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, d := range []interface{}{model.Bookmark{}, model.Reaction{}} {
+		if err := tx.Where("user_id = ?", m.ID).Delete(d).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	err := tx.Unscoped().Where("user_id = ? OR actor_id = ?", m.ID, m.ID).
+		Delete(model.Notification{}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if removeContents {
+		if err := deleteContents(tx, m); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	a := *m
+	a.Anonymize(time.Now())
+	a.FollowersCount = 0
+	a.FollowingCount = 0
+	if removeContents {
+		a.ArticlesCount = 0
+		a.FavoritesCount = 0
+	}
+	columns := map[string]interface{}{
+		"username":        a.Username,
+		"email":           a.Email,
+		"password":        a.Password,
+		"bio":             a.Bio,
+		"image":           a.Image,
+		"admin":           a.Admin,
+		"followers_count": a.FollowersCount,
+		"following_count": a.FollowingCount,
+		"anonymized_at":   a.AnonymizedAt,
+	}
+	if removeContents {
+		columns["articles_count"] = a.ArticlesCount
+		columns["favorites_count"] = a.FavoritesCount
+	}
+	if err := tx.Model(m).UpdateColumns(columns).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := addEvents(tx, model.NewUserEvent(model.EventUserDeleted, m)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	*m = a
+
+	return nil
+}
+
+// deleteFollows deletes follow relationships from and to the user in the transaction
+func deleteFollows(tx *gorm.DB, m *model.User) error {
+	var followingIDs, followerIDs []uint
+	if err := tx.Table("follows").Where("from_user_id = ?", m.ID).Pluck("to_user_id", &followingIDs).Error; err != nil {
+		return err
+	}
+	if err := tx.Table("follows").Where("to_user_id = ?", m.ID).Pluck("from_user_id", &followerIDs).Error; err != nil {
+		return err
+	}
+
+	for _, id := range followingIDs {
+		if err := unfollow(tx, m, &model.User{Model: gorm.Model{ID: id}}); err != nil {
+			return err
+		}
+	}
+	for _, id := range followerIDs {
+		if err := unfollow(tx, &model.User{Model: gorm.Model{ID: id}}, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteFavorites deletes favorites the user gave and uncounts them
+// on the articles and their authors in the transaction
+func deleteFavorites(tx *gorm.DB, m *model.User) error {
+	var as []model.Article
+	err := tx.Unscoped().
+		Joins("join favorite_articles on articles.id = favorite_articles.article_id").
+		Where("favorite_articles.user_id = ?", m.ID).
+		Find(&as).Error
+	if err != nil {
+		return err
+	}
+
+	for _, a := range as {
+		err := tx.Unscoped().Model(&a).
+			UpdateColumn("favorites_count", gorm.Expr("favorites_count - ?", 1)).Error
+		if err != nil {
+			return err
+		}
+
+		// favorites on deleted articles are already uncounted from their authors
+		if a.DeletedAt != nil {
+			continue
+		}
+		if err := addCount(tx, a.UserID, "favorites_count", -1); err != nil {
+			return err
+		}
+	}
+
+	return tx.Exec("DELETE FROM favorite_articles WHERE user_id = ?", m.ID).Error
+}
+
+// deleteContents permanently deletes the user's articles and comments in the transaction
+func deleteContents(tx *gorm.DB, m *model.User) error {
+	var articleIDs, commentIDs []uint
+	if err := tx.Unscoped().Model(&model.Article{}).Where("user_id = ?", m.ID).Pluck("id", &articleIDs).Error; err != nil {
+		return err
+	}
+	if err := purgeArticles(tx, articleIDs); err != nil {
+		return err
+	}
+
+	if err := tx.Unscoped().Model(&model.Comment{}).Where("user_id = ?", m.ID).Pluck("id", &commentIDs).Error; err != nil {
+		return err
+	}
+	return purgeComments(tx, commentIDs)
+}
+
+// ReconcileCounters recounts counters of every user from what they count
+// to fix drift, and returns the number of users whose counters were fixed
+func (s *UserStore) ReconcileCounters() (int64, error) {
+	d := s.db.Exec(
+		"UPDATE users SET "+
+			"followers_count = (SELECT COUNT(*) FROM follows WHERE follows.to_user_id = users.id), "+
+			"following_count = (SELECT COUNT(*) FROM follows WHERE follows.from_user_id = users.id), "+
+			"articles_count = (SELECT COUNT(*) FROM articles WHERE articles.user_id = users.id "+
+			"AND articles.status = ? AND articles.deleted_at IS NULL), "+
+			"favorites_count = (SELECT COUNT(*) FROM favorite_articles "+
+			"JOIN articles ON articles.id = favorite_articles.article_id "+
+			"WHERE articles.user_id = users.id AND articles.deleted_at IS NULL) "+
+			"WHERE users.deleted_at IS NULL",
+		model.ArticleStatusPublished)
+	return d.RowsAffected, d.Error
+}
@@ -0,0 +1,149 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/raahii/golang-grpc-realworld-example/model"
+	"github.com/raahii/golang-grpc-realworld-example/tracing"
+)
+
+// WebhookStore is data access struct for webhook
+type WebhookStore struct {
+	db *gorm.DB
+}
+
+// NewWebhookStore returns a new WebhookStore
+func NewWebhookStore(db *gorm.DB) *WebhookStore {
+	return &WebhookStore{
+		db: db,
+	}
+}
+
+// WithContext returns the WebhookStore whose queries are traced in ctx
+func (s *WebhookStore) WithContext(ctx context.Context) *WebhookStore {
+	return &WebhookStore{
+		db: tracing.WithContext(s.db, ctx),
+	}
+}
+
+// GetByID finds a webhook from id
+func (s *WebhookStore) GetByID(id uint) (*model.Webhook, error) {
+	var m model.Webhook
+	if err := s.db.First(&m, id).Error; err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// GetWebhooks returns all webhooks
+func (s *WebhookStore) GetWebhooks() ([]model.Webhook, error) {
+	var ws []model.Webhook
+	err := s.db.Order("id").Find(&ws).Error
+	return ws, err
+}
+
+// GetActiveWebhooks returns active webhooks subscribing the event
+func (s *WebhookStore) GetActiveWebhooks(event string) ([]model.Webhook, error) {
+	var ws []model.Webhook
+	if err := s.db.Where("active = ?", true).Find(&ws).Error; err != nil {
+		return nil, err
+	}
+
+	var subscribing []model.Webhook
+	for _, w := range ws {
+		if w.Subscribes(event) {
+			subscribing = append(subscribing, w)
+		}
+	}
+
+	return subscribing, nil
+}
+
+// Create creates a webhook
+func (s *WebhookStore) Create(m *model.Webhook) error {
+	return s.db.Create(m).Error
+}
+
+// Update updates all of webhook fields
+func (s *WebhookStore) Update(m *model.Webhook) error {
+	// Save, not Update, not to skip zero values such as inactive
+	return s.db.Save(m).Error
+}
+
+// Delete deletes a webhook with its deliveries
+func (s *WebhookStore) Delete(m *model.Webhook) error {
+	tx := s.db.Begin()
+
+	if err := tx.Delete(m).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err := tx.Where("webhook_id = ?", m.ID).Delete(model.WebhookDelivery{}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// CreateDelivery creates a webhook delivery
+func (s *WebhookStore) CreateDelivery(m *model.WebhookDelivery) error {
+	return s.db.Create(m).Error
+}
+
+// UpdateDelivery updates all of delivery fields
+func (s *WebhookStore) UpdateDelivery(m *model.WebhookDelivery) error {
+	return s.db.Save(m).Error
+}
+
+// GetDeliveryByID finds a delivery of the webhook from id
+func (s *WebhookStore) GetDeliveryByID(w *model.Webhook, id uint) (*model.WebhookDelivery, error) {
+	var m model.WebhookDelivery
+	err := s.db.Where("webhook_id = ?", w.ID).First(&m, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// GetDeliveries returns deliveries of the webhook, newest first
+func (s *WebhookStore) GetDeliveries(w *model.Webhook, limit, offset int64) ([]model.WebhookDelivery, error) {
+	var ds []model.WebhookDelivery
+	err := s.db.Where("webhook_id = ?", w.ID).
+		Order("created_at desc, id desc").
+		Offset(offset).Limit(limit).
+		Find(&ds).Error
+	return ds, err
+}
+
+// GetDueDeliveries returns pending deliveries whose next attempt is before t
+func (s *WebhookStore) GetDueDeliveries(t time.Time, limit int64) ([]model.WebhookDelivery, error) {
+	var ds []model.WebhookDelivery
+	err := s.db.Where("status = ? AND next_attempt_at <= ?", model.DeliveryPending, t).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&ds).Error
+	return ds, err
+}
+
+// ClaimDelivery leases the delivery due at t until the given time, so that
+// other servers do not send it meanwhile. It returns false if the delivery
+// is not due anymore, having been claimed by another server.
+func (s *WebhookStore) ClaimDelivery(m *model.WebhookDelivery, t, until time.Time) (bool, error) {
+	d := s.db.Model(&model.WebhookDelivery{}).
+		Where("id = ? AND status = ? AND next_attempt_at <= ?", m.ID, model.DeliveryPending, t).
+		UpdateColumn("next_attempt_at", until)
+	if d.Error != nil {
+		return false, d.Error
+	}
+	if d.RowsAffected == 0 {
+		return false, nil
+	}
+
+	m.NextAttemptAt = &until
+	return true, nil
+}
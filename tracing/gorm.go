@@ -0,0 +1,114 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithContext returns the database whose queries are recorded as spans in the
+// trace of ctx. The database is returned as is unless the trace is recorded.
+func WithContext(db *gorm.DB, ctx context.Context) *gorm.DB {
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return db
+	}
+
+	d := db.New()
+	d.SetLogger(&queryLogger{ctx: ctx})
+	return d.LogMode(true)
+}
+
+// queryLogger makes spans of queries from what gorm logs after running them
+type queryLogger struct {
+	ctx context.Context
+
+	mu  sync.Mutex
+	err error
+}
+
+// Print receives log entries of gorm. Statements are logged with their durations
+// and numbers of affected rows, after the errors they caused if any.
+func (l *queryLogger) Print(v ...interface{}) {
+	if len(v) == 0 {
+		return
+	}
+
+	switch v[0] {
+	case "log":
+		if len(v) < 3 {
+			return
+		}
+		if err, ok := v[2].(error); ok {
+			l.mu.Lock()
+			l.err = err
+			l.mu.Unlock()
+		}
+	case "sql":
+		if len(v) < 6 {
+			return
+		}
+		caller, _ := v[1].(string)
+		d, _ := v[2].(time.Duration)
+		stmt, _ := v[3].(string)
+		rows, _ := v[5].(int64)
+
+		l.mu.Lock()
+		err := l.err
+		l.err = nil
+		l.mu.Unlock()
+
+		end := time.Now()
+		_, span := otel.Tracer(tracerName).Start(l.ctx, queryName(stmt),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithTimestamp(end.Add(-d)),
+			trace.WithAttributes(
+				semconv.DBSystemMySQL,
+				semconv.DBStatement(stmt),
+				attribute.Int64("db.rows_affected", rows),
+				attribute.String("code.caller", caller),
+			),
+		)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End(trace.WithTimestamp(end))
+	}
+}
+
+// queryName returns the span name of the statement, which is its operation
+// and the table it works on such as "SELECT articles"
+func queryName(stmt string) string {
+	fields := strings.Fields(stmt)
+	if len(fields) == 0 {
+		return "query"
+	}
+
+	op := strings.ToUpper(fields[0])
+	var keyword string
+	switch op {
+	case "SELECT", "DELETE":
+		keyword = "FROM"
+	case "INSERT":
+		keyword = "INTO"
+	case "UPDATE":
+		keyword = "UPDATE"
+	default:
+		return op
+	}
+
+	for i, f := range fields[:len(fields)-1] {
+		if strings.ToUpper(f) == keyword {
+			return op + " " + strings.Trim(fields[i+1], "`\"(")
+		}
+	}
+	return op
+}
@@ -0,0 +1,115 @@
+// Package tracing sets up OpenTelemetry tracing of the server and the gateway
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Exporters of spans
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+)
+
+// tracerName is the name of the tracer of spans made in this module
+const tracerName = "github.com/raahii/golang-grpc-realworld-example"
+
+// Config is the configuration of tracing
+type Config struct {
+	// ServiceName is the name of the service in spans
+	ServiceName string
+	// Exporter is one of ExporterNone, ExporterOTLP, ExporterStdout and ExporterFile.
+	// The OTLP exporter sends spans over HTTP, configured with OTEL_EXPORTER_OTLP_* variables.
+	Exporter string
+	// File is the path of the file ExporterFile appends spans to
+	File string
+}
+
+// ConfigFromEnv returns the configuration of the service from TRACING_EXPORTER and TRACING_FILE
+func ConfigFromEnv(serviceName string) Config {
+	return Config{
+		ServiceName: serviceName,
+		Exporter:    os.Getenv("TRACING_EXPORTER"),
+		File:        os.Getenv("TRACING_FILE"),
+	}
+}
+
+// Setup installs the global tracer provider and the W3C trace context propagator.
+// Without an exporter, trace contexts are still propagated but no spans are recorded.
+// The returned function flushes and stops exporting spans.
+func Setup(ctx context.Context, c Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var closer io.Closer
+	switch c.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		e, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+		}
+		exporter = e
+	case ExporterStdout:
+		e, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+		exporter = e
+	case ExporterFile:
+		if c.File == "" {
+			return nil, fmt.Errorf("file is required for the file exporter")
+		}
+		f, err := os.OpenFile(c.File, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		e, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to create file exporter: %w", err)
+		}
+		exporter, closer = e, f
+	default:
+		return nil, fmt.Errorf("unknown exporter: %s", c.Exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(),
+		resource.NewSchemaless(semconv.ServiceName(c.ServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if closer != nil {
+			if cerr := closer.Close(); err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}, nil
+}
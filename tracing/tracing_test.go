@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestQueryName(t *testing.T) {
+	for _, tt := range []struct {
+		stmt string
+		want string
+	}{
+		{"SELECT * FROM `articles` WHERE id = 1", "SELECT articles"},
+		{"select count(*) from users", "SELECT users"},
+		{"INSERT INTO `users` (`username`) VALUES ('foo')", "INSERT users"},
+		{"UPDATE `articles` SET `title` = 'a'", "UPDATE articles"},
+		{"DELETE FROM comments WHERE id IN (1)", "DELETE comments"},
+		{"BEGIN", "BEGIN"},
+		{"SELECT 1", "SELECT"},
+		{"", "query"},
+	} {
+		assert.Equal(t, tt.want, queryName(tt.stmt), tt.stmt)
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	db := &gorm.DB{}
+	assert.Same(t, db, WithContext(db, context.Background()), "queries out of traces")
+}
+
+func TestQueryLogger(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(prev)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "request")
+	l := &queryLogger{ctx: ctx}
+
+	l.Print("sql", "store/user.go:10", time.Millisecond, "INSERT INTO `users` (`username`) VALUES (?)", []interface{}{"foo"}, int64(1))
+	l.Print("log", "store/user.go:20", errors.New("duplicate entry"))
+	l.Print("sql", "store/user.go:20", time.Millisecond, "INSERT INTO `users` (`username`) VALUES (?)", []interface{}{"foo"}, int64(0))
+	parent.End()
+
+	var spans []sdktrace.ReadOnlySpan
+	for _, s := range sr.Ended() {
+		if s.Name() == "INSERT users" {
+			spans = append(spans, s)
+		}
+	}
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+
+	for _, s := range spans {
+		assert.Equal(t, parent.SpanContext().TraceID(), s.SpanContext().TraceID())
+		assert.Equal(t, parent.SpanContext().SpanID(), s.Parent().SpanID())
+		assert.Equal(t, trace.SpanKindClient, s.SpanKind())
+		assert.Contains(t, s.Attributes(), attribute.String("db.system", "mysql"))
+		assert.Equal(t, time.Millisecond, s.EndTime().Sub(s.StartTime()))
+	}
+
+	assert.Contains(t, spans[0].Attributes(), attribute.Int64("db.rows_affected", 1))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code, "errors go to the next statement")
+	assert.Equal(t, "duplicate entry", spans[1].Status().Description)
+}
+
+func TestSetup(t *testing.T) {
+	for _, tt := range []struct {
+		title    string
+		config   Config
+		hasError bool
+	}{
+		{"no exporter", Config{ServiceName: "test"}, false},
+		{"file exporter", Config{ServiceName: "test", Exporter: ExporterFile, File: filepath.Join(t.TempDir(), "spans.json")}, false},
+		{"file exporter without file", Config{ServiceName: "test", Exporter: ExporterFile}, true},
+		{"unknown exporter", Config{ServiceName: "test", Exporter: "zipkin"}, true},
+	} {
+		func() {
+			prev := otel.GetTracerProvider()
+			defer otel.SetTracerProvider(prev)
+
+			shutdown, err := Setup(context.Background(), tt.config)
+			if tt.hasError {
+				assert.Error(t, err, tt.title)
+				return
+			}
+			if assert.NoError(t, err, tt.title) {
+				assert.NoError(t, shutdown(context.Background()), tt.title)
+			}
+		}()
+	}
+}
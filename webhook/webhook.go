@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/raahii/golang-grpc-realworld-example/backoff"
+)
+
+// headers of webhook requests
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// MaxAttempts is the number of attempts before a delivery is given up
+const MaxAttempts = 8
+
+// maxResponseBody is the size of response body kept in the delivery log
+const maxResponseBody = 1 << 10
+
+const (
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+)
+
+// Sign returns the signature of a request body. The timestamp is signed
+// together so that receivers can reject replayed requests.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns whether the signature of a request body is valid
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Backoff returns how long to wait before the next attempt
+// after the given number of failed attempts
+func Backoff(attempts int) time.Duration {
+	return backoff.Exponential(baseBackoff, maxBackoff, attempts)
+}
+
+// Request is a delivery of an event
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID uint
+	Payload    []byte
+}
+
+// Response is the result of a delivery
+type Response struct {
+	StatusCode int
+	Body       string
+}
+
+// Sender sends signed webhook requests
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender returns a new Sender
+func NewSender(client *http.Client) *Sender {
+	return &Sender{client: client}
+}
+
+// Send posts the payload to the webhook. It returns an error unless the
+// receiver responds with 2xx status, with the response if there is one.
+func (s *Sender) Send(ctx context.Context, r *Request, now time.Time) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(r.Payload))
+	if err != nil {
+		return nil, err
+	}
+
+	ts := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, r.Event)
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(uint64(r.DeliveryID), 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(r.Secret, ts, r.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if err != nil {
+		return nil, err
+	}
+	// drain the rest to reuse the connection
+	io.Copy(ioutil.Discard, resp.Body)
+
+	res := &Response{StatusCode: resp.StatusCode, Body: string(body)}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return res, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return res, nil
+}